@@ -23,9 +23,9 @@ type BalanceResponse struct {
 type Balance struct {
 	Access
 	WalletID          *string         `json:"walletId,omitempty"`
-	CurrentBalance    *float64        `json:"currentBalance,string,omitempty"`
-	Authorizations    *float64        `json:"authorizations,string,omitempty"`
-	AuthorizedBalance *float64        `json:"authorizedBalance,string,omitempty"`
+	CurrentBalance    *float64        `json:"currentBalance,string,omitempty" money:"Currency"`
+	Authorizations    *float64        `json:"authorizations,string,omitempty" money:"Currency"`
+	AuthorizedBalance *float64        `json:"authorizedBalance,string,omitempty" money:"Currency"`
 	Currency          Currency        `json:"currency,omitempty"`
 	CalculationDate   *TimestampParis `json:"calculationDate,omitempty"`
 }
@@ -50,7 +50,7 @@ func (s *BalanceService) List(ctx context.Context, opt *BalanceOptions) (*Balanc
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(BalanceResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "BalanceService.List"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
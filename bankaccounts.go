@@ -0,0 +1,123 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// BankAccountService handles communication with the bank account related
+// methods of the Treezor API.
+//
+// Treezor API docs: https://www.treezor.com/api-documentation/#/bankaccount
+type BankAccountService service
+
+// BankAccountResponse represents a list of external bank accounts.
+// It may contain only one item.
+type BankAccountResponse struct {
+	BankAccounts []*BankAccount `json:"bankaccounts"`
+}
+
+// BankAccount represents an external bank account of a user, used as the
+// destination of pay-outs.
+type BankAccount struct {
+	Access
+	BankAccountID           *string         `json:"bankaccountId,omitempty"`
+	BankAccountTag          *string         `json:"bankaccountTag,omitempty"`
+	BankAccountStatus       *string         `json:"bankaccountStatus,omitempty"`
+	UserID                  *string         `json:"userId,omitempty"`
+	BankAccountOwnerName    *string         `json:"bankaccountOwnerName,omitempty" treezor:"secret"`
+	BankAccountOwnerAddress *string         `json:"bankaccountOwnerAddress,omitempty" treezor:"secret"`
+	BankAccountIBAN         *string         `json:"bankaccountIBAN,omitempty" treezor:"secret,last4"`
+	BankAccountBIC          *string         `json:"bankaccountBIC,omitempty"`
+	BankAccountType         *string         `json:"bankaccountType,omitempty"`
+	CodeStatus              *string         `json:"codeStatus,omitempty"`
+	InformationStatus       *string         `json:"informationStatus,omitempty"`
+	CreatedDate             *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate            *TimestampParis `json:"modifiedDate,omitempty"`
+	TotalRows               *int64          `json:"totalRows,string,omitempty"`
+}
+
+// Create creates a Treezor bank account.
+// The required fields are UserID, BankAccountOwnerName, BankAccountOwnerAddress,
+// BankAccountIBAN and BankAccountBIC.
+func (s *BankAccountService) Create(ctx context.Context, bankAccount *BankAccount) (*BankAccount, *http.Response, error) {
+	req, _ := s.client.NewRequest(http.MethodPost, "bankaccounts", bankAccount)
+
+	b := new(BankAccountResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "BankAccountService.Create"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.BankAccounts) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one bank account: %d bank accounts returned", len(b.BankAccounts))
+	}
+	return b.BankAccounts[0], resp, nil
+}
+
+// Get returns a bank account.
+func (s *BankAccountService) Get(ctx context.Context, bankAccountID string) (*BankAccount, *http.Response, error) {
+	u := fmt.Sprintf("bankaccounts/%s", bankAccountID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(BankAccountResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "BankAccountService.Get"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.BankAccounts) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one bank account: %d bank accounts returned", len(b.BankAccounts))
+	}
+	return b.BankAccounts[0], resp, nil
+}
+
+// BankAccountListOptions specifies the optional parameters to the BankAccountService.List.
+type BankAccountListOptions struct {
+	BankAccountStatus string `url:"bankaccountStatus,omitempty"`
+	UserID            string `url:"userId,omitempty"`
+	BankAccountIBAN   string `url:"bankaccountIBAN,omitempty"`
+	CreatedDateFrom   string `url:"createdDateFrom,omitempty"`
+	CreatedDateTo     string `url:"createdDateTo,omitempty"`
+
+	ListOptions
+}
+
+// List the bank accounts for the authenticated user.
+func (s *BankAccountService) List(ctx context.Context, opt *BankAccountListOptions) (*BankAccountResponse, *http.Response, error) {
+	u := "bankaccounts"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(BankAccountResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "BankAccountService.List"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	return b, resp, nil
+}
+
+// Cancel cancels a bank account. Its status is changed to CANCELED and it can
+// no longer be used for pay-outs.
+func (s *BankAccountService) Cancel(ctx context.Context, bankAccountID string) (*BankAccount, *http.Response, error) {
+	u := fmt.Sprintf("bankaccounts/%s", bankAccountID)
+	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
+
+	b := new(BankAccountResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "BankAccountService.Cancel"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.BankAccounts) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one bank account: %d bank accounts returned", len(b.BankAccounts))
+	}
+	return b.BankAccounts[0], resp, nil
+}
@@ -0,0 +1,94 @@
+package treezor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBankAccountService_Create(t *testing.T) {
+	var body map[string]interface{}
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/bankaccounts", r.URL.Path)
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{"bankaccounts":[{"bankaccountId":"1","bankaccountStatus":"VALIDATED"}]}`))
+	})
+	defer teardown()
+
+	ba, _, err := client.BankAccount.Create(context.Background(), &BankAccount{
+		UserID:          String("42"),
+		BankAccountIBAN: String("FR7630001007941234567890185"),
+		BankAccountBIC:  String("BDFEFRPPCCT"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1", ba.GetBankAccountID())
+	assert.Equal(t, "VALIDATED", ba.GetBankAccountStatus())
+	assert.Equal(t, map[string]interface{}{
+		"userId":          "42",
+		"bankaccountIBAN": "FR7630001007941234567890185",
+		"bankaccountBIC":  "BDFEFRPPCCT",
+	}, body)
+}
+
+func TestBankAccountService_Cancel(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/bankaccounts/1", r.URL.Path)
+		w.Write([]byte(`{"bankaccounts":[{"bankaccountId":"1","bankaccountStatus":"CANCELED"}]}`))
+	})
+	defer teardown()
+
+	ba, _, err := client.BankAccount.Cancel(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "CANCELED", ba.GetBankAccountStatus())
+}
+
+func TestBankAccountService_List(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/bankaccounts", r.URL.Path)
+		assert.Equal(t, "bankaccountStatus=VALIDATED&pageCount=2&pageNumber=3&userId=42", r.URL.RawQuery)
+		w.Write([]byte(`{"bankaccounts":[{"bankaccountId":"1"},{"bankaccountId":"2"}]}`))
+	})
+	defer teardown()
+
+	r, _, err := client.BankAccount.List(context.Background(), &BankAccountListOptions{
+		BankAccountStatus: "VALIDATED",
+		UserID:            "42",
+		ListOptions:       ListOptions{Page: 3, PerPage: 2},
+	})
+	require.NoError(t, err)
+	assert.Len(t, r.BankAccounts, 2)
+}
+
+func TestBankAccountService_Get(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/bankaccounts/1", r.URL.Path)
+			w.Write([]byte(`{"bankaccounts":[{"bankaccountId":"1"}]}`))
+		})
+		defer teardown()
+
+		ba, _, err := client.BankAccount.Get(context.Background(), "1")
+		require.NoError(t, err)
+		assert.Equal(t, "1", ba.GetBankAccountID())
+	})
+	t.Run("Error not exactly one bank account", func(t *testing.T) {
+		for _, payload := range []string{`{"bankaccounts":[]}`, `{"bankaccounts":[{"bankaccountId":"1"},{"bankaccountId":"2"}]}`} {
+			client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(payload))
+			})
+
+			ba, _, err := client.BankAccount.Get(context.Background(), "1")
+			teardown()
+			assert.Nil(t, ba)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "exactly one bank account")
+		}
+	})
+}
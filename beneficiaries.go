@@ -27,9 +27,9 @@ type Beneficiary struct {
 	UserID                             *json.Number       `json:"userId,omitempty"`
 	Tag                                *string            `json:"tag,omitempty"`
 	NickName                           *string            `json:"nickName,omitempty"`
-	Name                               *string            `json:"name,omitempty"`
-	Address                            *string            `json:"address,omitempty"`
-	EncryptedIBAN                      *string            `json:"iban,omitempty"`
+	Name                               *string            `json:"name,omitempty" treezor:"secret"`
+	Address                            *string            `json:"address,omitempty" treezor:"secret"`
+	EncryptedIBAN                      *string            `json:"iban,omitempty" treezor:"secret,last4"`
 	BIC                                *string            `json:"bic,omitempty"`
 	SEPACreditorIdentifier             *string            `json:"sepaCreditorIdentifier,omitempty"`
 	UsableForSCT                       *bool              `json:"usableForSct,omitempty"`
@@ -47,9 +47,9 @@ type BeneficiaryRequest struct {
 	UserID                             *string            `json:"userId,omitempty"`
 	Tag                                *string            `json:"tag,omitempty"`
 	NickName                           *string            `json:"nickName,omitempty"`
-	Name                               *string            `json:"name,omitempty"`
-	Address                            *string            `json:"address,omitempty"`
-	IBAN                               *string            `json:"iban,omitempty"`
+	Name                               *string            `json:"name,omitempty" treezor:"secret"`
+	Address                            *string            `json:"address,omitempty" treezor:"secret"`
+	IBAN                               *string            `json:"iban,omitempty" treezor:"secret,last4"`
 	BIC                                *string            `json:"bic,omitempty"`
 	SEPACreditorIdentifier             *string            `json:"sepaCreditorIdentifier,omitempty"`
 	UsableForSCT                       *bool              `json:"usableForSct,omitempty"`
@@ -70,7 +70,7 @@ func (s *BeneficiaryService) Create(ctx context.Context, beneficiary *Beneficiar
 	req, _ := s.client.NewRequest(http.MethodPost, "beneficiaries", beneficiary)
 
 	b := new(BeneficiaryResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "BeneficiaryService.Create"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -87,7 +87,7 @@ func (s *BeneficiaryService) Get(ctx context.Context, beneficiaryID string) (*Be
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(BeneficiaryResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "BeneficiaryService.Get"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -101,6 +101,8 @@ func (s *BeneficiaryService) Get(ctx context.Context, beneficiaryID string) (*Be
 // BeneficiaryOptions specifies the optional parameters to the BeneficiaryService.List.
 type BeneficiaryOptions struct {
 	UserID string `url:"userId,omitempty"`
+
+	ListOptions
 }
 
 // List the beneficiaries for the authenticated user.s
@@ -113,7 +115,7 @@ func (s *BeneficiaryService) List(ctx context.Context, opt *BeneficiaryOptions)
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(BeneficiaryResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "BeneficiaryService.List"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -127,7 +129,7 @@ func (s *BeneficiaryService) Edit(ctx context.Context, beneficiaryID string, ben
 	req, _ := s.client.NewRequest(http.MethodPut, u, beneficiary)
 
 	b := new(BeneficiaryResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "BeneficiaryService.Edit"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
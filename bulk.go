@@ -0,0 +1,93 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const defaultGetManyConcurrency = 10
+
+// GetManyOptions specifies the optional parameters to the GetMany methods.
+type GetManyOptions struct {
+	// Concurrency is the maximum number of concurrent requests.
+	// Defaults to 10 if zero.
+	Concurrency int
+}
+
+func (o *GetManyOptions) concurrency() int {
+	if o == nil || o.Concurrency <= 0 {
+		return defaultGetManyConcurrency
+	}
+	return o.Concurrency
+}
+
+// GetManyError is returned by the GetMany methods when some objects could not
+// be fetched.
+type GetManyError struct {
+	// IDs are the requested IDs.
+	IDs []string
+	// Errors are the errors of the requests, in the order of IDs. They are
+	// nil for the objects which were fetched.
+	Errors []error
+}
+
+func (e *GetManyError) Error() string {
+	failed := 0
+	for _, err := range e.Errors {
+		if err != nil {
+			failed++
+		}
+	}
+	return fmt.Sprintf("%d of %d gets failed, first error: %v", failed, len(e.IDs), e.Unwrap())
+}
+
+// Unwrap returns the first error.
+func (e *GetManyError) Unwrap() error {
+	for _, err := range e.Errors {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getMany calls get for each of ids, with at most opt.Concurrency concurrent
+// calls. It returns a *GetManyError if any call failed. The IDs not fetched
+// yet when ctx is done fail with ctx.Err().
+func getMany(ctx context.Context, ids []string, opt *GetManyOptions, get func(ctx context.Context, i int) error) error {
+	errs := make([]error, len(ids))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < opt.concurrency() && w < len(ids); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = get(ctx, i)
+			}
+		}()
+	}
+
+	i := 0
+feed:
+	for ; i < len(ids); i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	for ; i < len(ids); i++ {
+		errs[i] = ctx.Err()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return &GetManyError{IDs: ids, Errors: errs}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardService_GetMany(t *testing.T) {
+	var inFlight, maxInFlight int32
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		id := strings.TrimPrefix(r.URL.Path, "/cards/")
+		if id == "13" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"cards":[{"cardId":"%s"}]}`, id)
+	})
+	defer teardown()
+	ctx := context.Background()
+
+	var ids []string
+	for i := 0; i < 20; i++ {
+		ids = append(ids, fmt.Sprint(i))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		cards, err := client.Card.GetMany(ctx, ids[:10], &GetManyOptions{Concurrency: 3})
+		assert.Nil(t, err)
+		for i, c := range cards {
+			assert.Equal(t, ids[i], c.GetCardID())
+		}
+		assert.Equal(t, int32(3), atomic.LoadInt32(&maxInFlight))
+	})
+	t.Run("Per ID errors", func(t *testing.T) {
+		cards, err := client.Card.GetMany(ctx, ids, nil)
+		var getErr *GetManyError
+		assert.True(t, errors.As(err, &getErr))
+		for i := range ids {
+			if i == 13 {
+				assert.Nil(t, cards[i])
+				assert.NotNil(t, getErr.Errors[i])
+				continue
+			}
+			assert.Equal(t, ids[i], cards[i].GetCardID())
+			assert.Nil(t, getErr.Errors[i])
+		}
+		assert.Contains(t, err.Error(), "1 of 20 gets failed")
+	})
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		cards, err := client.Card.GetMany(ctx, ids, nil)
+		assert.Len(t, cards, 20)
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
@@ -0,0 +1,22 @@
+package treezor
+
+// CardDigitalizationResponse represents a list of card digitalizations.
+// It may contain only one item.
+type CardDigitalizationResponse struct {
+	CardDigitalizations []*CardDigitalization `json:"cardDigitalizations"`
+}
+
+// CardDigitalization represents the tokenization of a card in a wallet
+// provider such as Apple Pay or Google Pay.
+type CardDigitalization struct {
+	ID                   *string         `json:"id,omitempty"`
+	ExternalID           *string         `json:"externalId,omitempty"`
+	CardID               *string         `json:"cardId,omitempty"`
+	Status               *string         `json:"status,omitempty"`
+	TokenRequestor       *string         `json:"tokenRequestor,omitempty"`
+	DeviceType           *string         `json:"deviceType,omitempty"`
+	ActivationCode       *string         `json:"activationCode,omitempty"`
+	ActivationCodeExpiry *string         `json:"activationCodeExpiry,omitempty"`
+	CreatedDate          *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate         *TimestampParis `json:"modifiedDate,omitempty"`
+}
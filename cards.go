@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/pkg/errors"
 )
@@ -18,14 +19,6 @@ const (
 	All         = 15
 )
 
-// Error code for given status
-const (
-	ErrCodeCardWrongPIN = 32056
-	ErrCodeCardLost     = 32095
-	ErrCodeCardStolen   = 32096
-	ErrCodeCardBlocked  = 32111
-)
-
 // ConvertPermissions map binary field of card permission to
 // an internal value at Treezor which groups those permissions.
 //
@@ -62,6 +55,7 @@ type Card struct {
 	CardDesign                 *string          `json:"cardDesign,omitempty"`
 	MccRestrictionGroupID      *string          `json:"mccRestrictionGroupId,omitempty"`
 	MerchantRestrictionGroupID *string          `json:"merchantRestrictionGroupId,omitempty"`
+	CountryRestrictionGroupID  *string          `json:"countryRestrictionGroupId,omitempty"`
 	PublicToken                *string          `json:"publicToken,omitempty"`
 	IsPhysical                 *int64           `json:"physical,string,omitempty"`
 	CardTag                    *string          `json:"cardTag,omitempty"`
@@ -70,22 +64,22 @@ type Card struct {
 	IsLive                     *int64           `json:"isLive,string,omitempty"`
 	PINTryExceeds              *int64           `json:"pinTryExceeds,string,omitempty"`
 	MaskedPan                  *string          `json:"maskedPan,omitempty"`
-	EmbossedName               *string          `json:"embossedName,omitempty"`
+	EmbossedName               *string          `json:"embossedName,omitempty" treezor:"secret"`
 	ExpiryDate                 *Date            `json:"expiryDate,omitempty"`
-	CVV                        *string          `json:"CVV,omitempty"`
+	CVV                        *string          `json:"CVV,omitempty" treezor:"secret"`
 	StartDate                  *Date            `json:"startDate,omitempty"`
 	EndDate                    *Date            `json:"endDate,omitempty"`
 	CountryCode                *string          `json:"countryCode,omitempty"`
 	CurrencyCode               Currency         `json:"currencyCode,omitempty"`
 	Lang                       *string          `json:"lang,omitempty"`
 	DeliveryTitle              *string          `json:"deliveryTitle,omitempty"`
-	DeliveryFirstname          *string          `json:"deliveryFirstname,omitempty"`
-	DeliveryLastname           *string          `json:"deliveryLastname,omitempty"`
-	DeliveryAddress1           *string          `json:"deliveryAddress1,omitempty"`
-	DeliveryAddress2           *string          `json:"deliveryAddress2,omitempty"`
-	DeliveryAddress3           *string          `json:"deliveryAddress3,omitempty"`
+	DeliveryFirstname          *string          `json:"deliveryFirstname,omitempty" treezor:"secret"`
+	DeliveryLastname           *string          `json:"deliveryLastname,omitempty" treezor:"secret"`
+	DeliveryAddress1           *string          `json:"deliveryAddress1,omitempty" treezor:"secret"`
+	DeliveryAddress2           *string          `json:"deliveryAddress2,omitempty" treezor:"secret"`
+	DeliveryAddress3           *string          `json:"deliveryAddress3,omitempty" treezor:"secret"`
 	DeliveryCity               *string          `json:"deliveryCity,omitempty"`
-	DeliveryPostcode           *string          `json:"deliveryPostcode,omitempty"`
+	DeliveryPostcode           *string          `json:"deliveryPostcode,omitempty" treezor:"secret"`
 	DeliveryCountry            *string          `json:"deliveryCountry,omitempty"`
 	MobileSent                 *string          `json:"mobileSent,omitempty"`
 	LimitsGroup                *string          `json:"limitsGroup,omitempty"`
@@ -94,7 +88,7 @@ type Card struct {
 	OptionForeign              *int64           `json:"optionForeign,string,omitempty"`
 	OptionOnline               *int64           `json:"optionOnline,string,omitempty"`
 	OptionNFC                  *int64           `json:"optionNfc,string,omitempty"`
-	PIN                        *string          `json:"pin,omitempty"`
+	PIN                        *string          `json:"pin,omitempty" treezor:"secret"`
 	LimitATMYear               *int64           `json:"limitAtmYear,string,omitempty"`
 	LimitATMMonth              *int64           `json:"limitAtmMonth,string,omitempty"`
 	LimitATMWeek               *int64           `json:"limitAtmWeek,string,omitempty"`
@@ -105,16 +99,16 @@ type Card struct {
 	LimitPaymentWeek           *int64           `json:"limitPaymentWeek,string,omitempty"`
 	LimitPaymentDay            *int64           `json:"limitPaymentDay,string,omitempty"`
 	LimitPaymentAll            *int64           `json:"limitPaymentAll,string,omitempty"`
-	TotalATMYear               *float64         `json:"totalAtmYear,string,omitempty"`
-	TotalATMMonth              *float64         `json:"totalAtmMonth,string,omitempty"`
-	TotalATMWeek               *float64         `json:"totalAtmWeek,string,omitempty"`
-	TotalATMDay                *float64         `json:"totalAtmDay,string,omitempty"`
-	TotalATMAll                *float64         `json:"totalAtmAll,string,omitempty"`
-	TotalPaymentYear           *float64         `json:"totalPaymentYear,string,omitempty"`
-	TotalPaymentMonth          *float64         `json:"totalPaymentMonth,string,omitempty"`
-	TotalPaymentWeek           *float64         `json:"totalPaymentWeek,string,omitempty"`
-	TotalPaymentDay            *float64         `json:"totalPaymentDay,string,omitempty"`
-	TotalPaymentAll            *float64         `json:"totalPaymentAll,string,omitempty"`
+	TotalATMYear               *float64         `json:"totalAtmYear,string,omitempty" money:"CurrencyCode"`
+	TotalATMMonth              *float64         `json:"totalAtmMonth,string,omitempty" money:"CurrencyCode"`
+	TotalATMWeek               *float64         `json:"totalAtmWeek,string,omitempty" money:"CurrencyCode"`
+	TotalATMDay                *float64         `json:"totalAtmDay,string,omitempty" money:"CurrencyCode"`
+	TotalATMAll                *float64         `json:"totalAtmAll,string,omitempty" money:"CurrencyCode"`
+	TotalPaymentYear           *float64         `json:"totalPaymentYear,string,omitempty" money:"CurrencyCode"`
+	TotalPaymentMonth          *float64         `json:"totalPaymentMonth,string,omitempty" money:"CurrencyCode"`
+	TotalPaymentWeek           *float64         `json:"totalPaymentWeek,string,omitempty" money:"CurrencyCode"`
+	TotalPaymentDay            *float64         `json:"totalPaymentDay,string,omitempty" money:"CurrencyCode"`
+	TotalPaymentAll            *float64         `json:"totalPaymentAll,string,omitempty" money:"CurrencyCode"`
 	CreatedBy                  *string          `json:"createdBy,omitempty"`
 	CreatedDate                *TimestampLondon `json:"createdDate,omitempty"`
 	ModifiedBy                 *string          `json:"modifiedBy,omitempty"`
@@ -127,7 +121,7 @@ func (s *CardService) CreateVirtual(ctx context.Context, card *Card) (*Card, *ht
 	req, _ := s.client.NewRequest(http.MethodPost, "cards/CreateVirtual", card)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.CreateVirtual"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -143,7 +137,7 @@ func (s *CardService) RequestPhysical(ctx context.Context, card *Card) (*Card, *
 	req, _ := s.client.NewRequest(http.MethodPost, "cards/RequestPhysical", card)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.RequestPhysical"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -168,7 +162,7 @@ type CardImagesResponse struct {
 type CardImage struct {
 	ID     *string `json:"id,omitempty"`
 	CardID *string `json:"cardId,omitempty"`
-	File   *string `json:"file,omitempty"`
+	File   *string `json:"file,omitempty" treezor:"secret"`
 }
 
 // GetImage returns the provided virtual card image.
@@ -181,7 +175,7 @@ func (s *CardService) GetImage(ctx context.Context, opt *CardGetImagesOptions) (
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	c := new(CardImagesResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.GetImage"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -199,7 +193,7 @@ func (s *CardService) Get(ctx context.Context, cardID string) (*Card, *http.Resp
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.Get"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -210,6 +204,18 @@ func (s *CardService) Get(ctx context.Context, cardID string) (*Card, *http.Resp
 	return c.Cards[0], resp, nil
 }
 
+// GetMany returns the cards with the given IDs, in the same order,
+// fetching them concurrently. If some of them cannot be fetched, their entries
+// are nil and a *GetManyError holding the error of each ID is returned.
+func (s *CardService) GetMany(ctx context.Context, cardIDs []string, opt *GetManyOptions) ([]*Card, error) {
+	cards := make([]*Card, len(cardIDs))
+	err := getMany(ctx, cardIDs, opt, func(ctx context.Context, i int) (err error) {
+		cards[i], _, err = s.Get(ctx, cardIDs[i])
+		return err
+	})
+	return cards, err
+}
+
 // CardListOptions contains URL options for listing cards.
 type CardListOptions struct {
 	ListOptions
@@ -225,7 +231,7 @@ func (s *CardService) List(ctx context.Context, opt *CardListOptions) (*CardResp
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.List"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -239,7 +245,7 @@ func (s *CardService) Edit(ctx context.Context, cardID string, card *Card) (*Car
 	req, _ := s.client.NewRequest(http.MethodPut, u, card)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.Edit"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -250,13 +256,31 @@ func (s *CardService) Edit(ctx context.Context, cardID string, card *Card) (*Car
 	return c.Cards[0], resp, nil
 }
 
+// AttachMCCGroup restricts the MCCs the card can pay at to the MCC
+// restriction group groupID.
+func (s *CardService) AttachMCCGroup(ctx context.Context, cardID string, groupID int64) (*Card, *http.Response, error) {
+	return s.Edit(ctx, cardID, &Card{MccRestrictionGroupID: String(strconv.FormatInt(groupID, 10))})
+}
+
+// AttachMerchantIDGroup restricts the merchants the card can pay at to the
+// merchant ID restriction group groupID.
+func (s *CardService) AttachMerchantIDGroup(ctx context.Context, cardID string, groupID int64) (*Card, *http.Response, error) {
+	return s.Edit(ctx, cardID, &Card{MerchantRestrictionGroupID: String(strconv.FormatInt(groupID, 10))})
+}
+
+// AttachCountryGroup restricts the countries the card can pay in to the
+// country restriction group groupID.
+func (s *CardService) AttachCountryGroup(ctx context.Context, cardID string, groupID int64) (*Card, *http.Response, error) {
+	return s.Edit(ctx, cardID, &Card{CountryRestrictionGroupID: String(strconv.FormatInt(groupID, 10))})
+}
+
 // Activate enable a card to make payments. It needs to be done only once.
 func (s *CardService) Activate(ctx context.Context, cardID string) (*Card, *http.Response, error) {
 	u := fmt.Sprintf("cards/%s/Activate/", cardID)
 	req, _ := s.client.NewRequest(http.MethodPut, u, nil)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.Activate"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -287,7 +311,7 @@ func (s *CardService) LockUnlock(ctx context.Context, cardID string, lockStatus
 	})
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.LockUnlock"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -312,7 +336,7 @@ func (s *CardService) ChangeOptions(ctx context.Context, cardID string, options
 	req, _ := s.client.NewRequest(http.MethodPut, u, options)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.ChangeOptions"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -343,7 +367,7 @@ func (s *CardService) ChangeLimits(ctx context.Context, cardID string, limits *C
 	req, _ := s.client.NewRequest(http.MethodPut, u, limits)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.ChangeLimits"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -360,7 +384,7 @@ func (s *CardService) Regenerate(ctx context.Context, cardID string) (*Card, *ht
 	req, _ := s.client.NewRequest(http.MethodPut, u, nil)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.Regenerate"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -377,7 +401,7 @@ func (s *CardService) ConvertVirtual(ctx context.Context, cardID string) (*Card,
 	req, _ := s.client.NewRequest(http.MethodPut, u, nil)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.ConvertVirtual"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -390,9 +414,9 @@ func (s *CardService) ConvertVirtual(ctx context.Context, cardID string) (*Card,
 
 // PIN is used to make PIN modification operations.
 type PIN struct {
-	Current      string `json:"currentPIN,omitempty"`
-	New          string `json:"newPIN,omitempty"`
-	Confirmation string `json:"confirmPIN,omitempty"`
+	Current      string `json:"currentPIN,omitempty" treezor:"secret"`
+	New          string `json:"newPIN,omitempty" treezor:"secret"`
+	Confirmation string `json:"confirmPIN,omitempty" treezor:"secret"`
 }
 
 // ChangePIN changes the card PIN. It needs the current PIN, the new one and a confirmation one.
@@ -401,7 +425,7 @@ func (s *CardService) ChangePIN(ctx context.Context, cardID string, pin *PIN) (*
 	req, _ := s.client.NewRequest(http.MethodPut, u, pin)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.ChangePIN"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -419,7 +443,7 @@ func (s *CardService) SetPIN(ctx context.Context, cardID string, pin *PIN) (*Car
 	req, _ := s.client.NewRequest(http.MethodPut, u, pin)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.SetPIN"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -436,7 +460,7 @@ func (s *CardService) UnblockPIN(ctx context.Context, cardID string) (*Card, *ht
 	req, _ := s.client.NewRequest(http.MethodPut, u, nil)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.UnblockPIN"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -453,7 +477,7 @@ func (s *CardService) Deactivate(ctx context.Context, cardID string) (*Card, *ht
 	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.Deactivate"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -475,7 +499,7 @@ func (s *CardService) Register3DSecure(ctx context.Context, cardID *Card3DS) (*C
 	req, _ := s.client.NewRequest(http.MethodPost, "cards/Register3DS", cardID)
 
 	c := new(CardResponse)
-	resp, err := s.client.Do(ctx, req, c)
+	resp, err := s.client.Do(WithOperation(ctx, "CardService.Register3DSecure"), req, c)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
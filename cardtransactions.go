@@ -32,9 +32,9 @@ type CardTransaction struct {
 	MerchantCountry           *string          `json:"merchantCountry,omitempty"`
 	PaymentLocalTime          *string          `json:"paymentLocalTime,omitempty"`
 	PublicToken               *string          `json:"publicToken,omitempty"`
-	PaymentAmount             *float64         `json:"paymentAmount,string,omitempty"`
+	PaymentAmount             *float64         `json:"paymentAmount,string,omitempty" money:"PaymentCurrency"`
 	PaymentCurrency           *string          `json:"paymentCurrency,omitempty"`
-	Fees                      *float64         `json:"fees,string,omitempty"`
+	Fees                      *float64         `json:"fees,string,omitempty" money:"PaymentCurrency"`
 	Is3DS                     *string          `json:"is3DS,omitempty"`
 	PaymentCountry            *string          `json:"paymentCountry,omitempty"`
 	PaymentID                 *string          `json:"paymentId,omitempty"`
@@ -51,7 +51,7 @@ type CardTransaction struct {
 	AuthorizationIssuerID     *string          `json:"authorizationIssuerId,omitempty"`
 	AuthorizationIssuerTime   *TimestampLondon `json:"authorizationIssuerTime,omitempty"`
 	AuthorizationMti          *string          `json:"authorizationMti,omitempty"`
-	AuthorizedBalance         *float64         `json:"authorizedBalance,string,omitempty"`
+	AuthorizedBalance         *float64         `json:"authorizedBalance,string,omitempty" money:"WalletCurrency"`
 	LimitATMYear              *int64           `json:"limitAtmYear,string,omitempty"`
 	LimitATMMonth             *int64           `json:"limitAtmMonth,string,omitempty"`
 	LimitATMWeek              *int64           `json:"limitAtmWeek,string,omitempty"`
@@ -62,16 +62,16 @@ type CardTransaction struct {
 	LimitPaymentWeek          *int64           `json:"limitPaymentWeek,string,omitempty"`
 	LimitPaymentDay           *int64           `json:"limitPaymentDay,string,omitempty"`
 	LimitPaymentAll           *int64           `json:"limitPaymentAll,string,omitempty"`
-	TotalLimitATMYear         *float64         `json:"totalLimitAtmYear,string,omitempty"`
-	TotalLimitATMMonth        *float64         `json:"totalLimitAtmMonth,string,omitempty"`
-	TotalLimitATMWeek         *float64         `json:"totalLimitAtmWeek,string,omitempty"`
-	TotalLimitATMDay          *float64         `json:"totalLimitAtmDay,string,omitempty"`
-	TotalLimitATMAll          *float64         `json:"totalLimitAtmAll,string,omitempty"`
-	TotalLimitPaymentYear     *float64         `json:"totalLimitPaymentYear,string,omitempty"`
-	TotalLimitPaymentMonth    *float64         `json:"totalLimitPaymentMonth,string,omitempty"`
-	TotalLimitPaymentWeek     *float64         `json:"totalLimitPaymentWeek,string,omitempty"`
-	TotalLimitPaymentDay      *float64         `json:"totalLimitPaymentDay,string,omitempty"`
-	TotalLimitPaymentAll      *float64         `json:"totalLimitPaymentAll,string,omitempty"`
+	TotalLimitATMYear         *float64         `json:"totalLimitAtmYear,string,omitempty" money:"WalletCurrency"`
+	TotalLimitATMMonth        *float64         `json:"totalLimitAtmMonth,string,omitempty" money:"WalletCurrency"`
+	TotalLimitATMWeek         *float64         `json:"totalLimitAtmWeek,string,omitempty" money:"WalletCurrency"`
+	TotalLimitATMDay          *float64         `json:"totalLimitAtmDay,string,omitempty" money:"WalletCurrency"`
+	TotalLimitATMAll          *float64         `json:"totalLimitAtmAll,string,omitempty" money:"WalletCurrency"`
+	TotalLimitPaymentYear     *float64         `json:"totalLimitPaymentYear,string,omitempty" money:"WalletCurrency"`
+	TotalLimitPaymentMonth    *float64         `json:"totalLimitPaymentMonth,string,omitempty" money:"WalletCurrency"`
+	TotalLimitPaymentWeek     *float64         `json:"totalLimitPaymentWeek,string,omitempty" money:"WalletCurrency"`
+	TotalLimitPaymentDay      *float64         `json:"totalLimitPaymentDay,string,omitempty" money:"WalletCurrency"`
+	TotalLimitPaymentAll      *float64         `json:"totalLimitPaymentAll,string,omitempty" money:"WalletCurrency"`
 	MccCode                   *string          `json:"mccCode,omitempty"`
 }
 
@@ -81,7 +81,7 @@ func (s *CardTransactionService) Get(ctx context.Context, cardTransactionID stri
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	ct := new(CardTransactionResponse)
-	resp, err := s.client.Do(ctx, req, ct)
+	resp, err := s.client.Do(WithOperation(ctx, "CardTransactionService.Get"), req, ct)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -114,7 +114,7 @@ func (s *CardTransactionService) List(ctx context.Context, opt *CardTransactions
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(CardTransactionResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "CardTransactionService.List"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
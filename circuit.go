@@ -0,0 +1,267 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultCircuitErrorRatio   = 0.5
+	defaultCircuitMinRequests  = 10
+	defaultCircuitWindow       = 30 * time.Second
+	defaultCircuitOpenTimeout  = 30 * time.Second
+	defaultCircuitProbeTimeout = 10 * time.Second
+)
+
+// ErrCircuitOpen is returned by Client.Do without sending the request while
+// the CircuitBreaker of the client is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+// List of CircuitState.
+const (
+	// CircuitClosed lets the requests through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails the requests fast with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen probes the API with HearthbeatService.Ping before
+	// closing again.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreaker stops a Client from sending requests while the Treezor API
+// is degraded. It opens when the ratio of failed calls, i.e. connection
+// errors, 429 and 5xx responses, reaches ErrorRatio. Calls then fail fast
+// with ErrCircuitOpen. After OpenTimeout, the next call probes the API with
+// HearthbeatService.Ping: the circuit closes if it succeeds and opens again
+// otherwise.
+//
+// The fields must not be modified once the CircuitBreaker is in use.
+type CircuitBreaker struct {
+	// ErrorRatio is the ratio of failed calls opening the circuit.
+	// Defaults to 0.5 if zero.
+	ErrorRatio float64
+
+	// MinRequests is the number of calls needed in a window before the
+	// circuit can open. Defaults to 10 if zero.
+	MinRequests int
+
+	// Window is the period over which calls are counted.
+	// Defaults to 30s if zero.
+	Window time.Duration
+
+	// OpenTimeout is the time the circuit stays open before probing the API.
+	// Defaults to 30s if zero.
+	OpenTimeout time.Duration
+
+	// ProbeTimeout bounds the HearthbeatService.Ping probing the API. The
+	// probe does not use the context of the call triggering it, so that
+	// canceling that call does not fail the probe. Defaults to 10s if zero.
+	ProbeTimeout time.Duration
+
+	// OnStateChange, if set, is called when the state of the circuit changes.
+	OnStateChange func(from, to CircuitState)
+
+	mu          sync.Mutex
+	state       CircuitState
+	windowStart time.Time
+	calls       int
+	failures    int
+	openedAt    time.Time
+	now         func() time.Time // Defaults to time.Now.
+}
+
+func (b *CircuitBreaker) errorRatio() float64 {
+	if b.ErrorRatio <= 0 {
+		return defaultCircuitErrorRatio
+	}
+	return b.ErrorRatio
+}
+
+func (b *CircuitBreaker) minRequests() int {
+	if b.MinRequests <= 0 {
+		return defaultCircuitMinRequests
+	}
+	return b.MinRequests
+}
+
+func (b *CircuitBreaker) window() time.Duration {
+	if b.Window <= 0 {
+		return defaultCircuitWindow
+	}
+	return b.Window
+}
+
+func (b *CircuitBreaker) openTimeout() time.Duration {
+	if b.OpenTimeout <= 0 {
+		return defaultCircuitOpenTimeout
+	}
+	return b.OpenTimeout
+}
+
+func (b *CircuitBreaker) probeTimeout() time.Duration {
+	if b.ProbeTimeout <= 0 {
+		return defaultCircuitProbeTimeout
+	}
+	return b.ProbeTimeout
+}
+
+func (b *CircuitBreaker) clock() time.Time {
+	if b.now == nil {
+		return time.Now()
+	}
+	return b.now()
+}
+
+// State returns the current state of the circuit.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// setState changes the state of the circuit and returns the callback to call
+// once b.mu is released. It must be called with b.mu held.
+func (b *CircuitBreaker) setState(to CircuitState, now time.Time) func() {
+	from := b.state
+	if from == to {
+		return func() {}
+	}
+	b.state = to
+	b.windowStart, b.calls, b.failures = now, 0, 0
+	if to == CircuitOpen {
+		b.openedAt = now
+	}
+	if b.OnStateChange == nil {
+		return func() {}
+	}
+	return func() { b.OnStateChange(from, to) }
+}
+
+// allow reports whether a call can be sent, probing the API with probe if
+// the circuit has been open for long enough.
+func (b *CircuitBreaker) allow(ctx context.Context, probe func(ctx context.Context) bool) error {
+	b.mu.Lock()
+	now := b.clock()
+	switch {
+	case b.state == CircuitClosed:
+		b.mu.Unlock()
+		return nil
+	case b.state == CircuitHalfOpen || now.Sub(b.openedAt) < b.openTimeout():
+		// Another call is probing the API, or it is too soon to probe it.
+		b.mu.Unlock()
+		return errors.WithStack(ErrCircuitOpen)
+	}
+	notify := b.setState(CircuitHalfOpen, now)
+	b.mu.Unlock()
+	notify()
+
+	// The probe decides the state of the circuit for every caller: it runs
+	// to completion even if ctx is done first.
+	done := make(chan bool, 1)
+	go func() { done <- b.runProbe(probe) }()
+	select {
+	case ok := <-done:
+		if !ok {
+			return errors.WithStack(ErrCircuitOpen)
+		}
+		return nil
+	case <-ctx.Done():
+		return errors.WithStack(ctx.Err())
+	}
+}
+
+// runProbe probes the API and closes or reopens the circuit depending on the
+// result.
+func (b *CircuitBreaker) runProbe(probe func(ctx context.Context) bool) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), b.probeTimeout())
+	defer cancel()
+	ok := probe(ctx)
+
+	b.mu.Lock()
+	to := CircuitOpen
+	if ok {
+		to = CircuitClosed
+	}
+	notify := b.setState(to, b.clock())
+	b.mu.Unlock()
+	notify()
+	return ok
+}
+
+// record counts the result of a call and opens the circuit if too many calls
+// failed.
+func (b *CircuitBreaker) record(resp *http.Response, err error) {
+	failed := isCircuitFailure(resp, err)
+	b.mu.Lock()
+	now := b.clock()
+	if b.state != CircuitClosed {
+		b.mu.Unlock()
+		return
+	}
+	if now.Sub(b.windowStart) >= b.window() {
+		b.windowStart, b.calls, b.failures = now, 0, 0
+	}
+	b.calls++
+	if failed {
+		b.failures++
+	}
+	notify := func() {}
+	if b.calls >= b.minRequests() && float64(b.failures)/float64(b.calls) >= b.errorRatio() {
+		notify = b.setState(CircuitOpen, now)
+	}
+	b.mu.Unlock()
+	notify()
+}
+
+// isCircuitFailure reports whether a call failed because of the API rather
+// than because of the request or its context.
+func isCircuitFailure(resp *http.Response, err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if resp == nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+type circuitProbeKey struct{}
+
+// breakCircuit wraps next to guard the calls with the CircuitBreaker of the
+// Client. The heartbeat probes bypass it.
+func (c *Client) breakCircuit(next Handler) Handler {
+	probe := func(ctx context.Context) bool {
+		ok, _, err := c.Hearthbeat.Ping(context.WithValue(ctx, circuitProbeKey{}, true))
+		return ok && err == nil
+	}
+	return func(ctx context.Context, op *Operation, v interface{}) (*http.Response, error) {
+		if ctx.Value(circuitProbeKey{}) != nil {
+			return next(ctx, op, v)
+		}
+		if err := c.CircuitBreaker.allow(ctx, probe); err != nil {
+			return nil, err
+		}
+		resp, err := next(ctx, op, v)
+		c.CircuitBreaker.record(resp, err)
+		return resp, err
+	}
+}
@@ -0,0 +1,147 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	var healthy, calls, pings int32
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/heartbeats" {
+			atomic.AddInt32(&pings, 1)
+		} else {
+			atomic.AddInt32(&calls, 1)
+		}
+		if atomic.LoadInt32(&healthy) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"wallets":[{"walletId":"1"}]}`))
+	})
+	defer teardown()
+
+	now := time.Unix(0, 0)
+	var changes []string
+	breaker := &CircuitBreaker{
+		MinRequests: 2,
+		OpenTimeout: time.Minute,
+		OnStateChange: func(from, to CircuitState) {
+			changes = append(changes, from.String()+" -> "+to.String())
+		},
+		now: func() time.Time { return now },
+	}
+	client.CircuitBreaker = breaker
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Wallet.Get(ctx, "1")
+		assert.NotNil(t, err)
+		assert.False(t, errors.Is(err, ErrCircuitOpen))
+	}
+	assert.Equal(t, CircuitOpen, breaker.State())
+
+	_, _, err := client.Wallet.Get(ctx, "1")
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	t.Run("Failed probe", func(t *testing.T) {
+		now = now.Add(time.Minute)
+		_, _, err := client.Wallet.Get(ctx, "1")
+		assert.True(t, errors.Is(err, ErrCircuitOpen))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&pings))
+		assert.Equal(t, CircuitOpen, breaker.State())
+	})
+	t.Run("Successful probe", func(t *testing.T) {
+		atomic.StoreInt32(&healthy, 1)
+		now = now.Add(time.Minute)
+		_, _, err := client.Wallet.Get(ctx, "1")
+		assert.Nil(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&pings))
+		assert.Equal(t, CircuitClosed, breaker.State())
+	})
+
+	assert.Equal(t, []string{
+		"closed -> open",
+		"open -> half-open",
+		"half-open -> open",
+		"open -> half-open",
+		"half-open -> closed",
+	}, changes)
+}
+
+func TestCircuitBreaker_IgnoresClientErrors(t *testing.T) {
+	b := &CircuitBreaker{MinRequests: 1, ErrorRatio: 0.3}
+	b.record(&http.Response{StatusCode: http.StatusBadRequest}, errors.New("insufficient funds"))
+	b.record(nil, context.Canceled)
+	assert.Equal(t, CircuitClosed, b.State())
+	b.record(nil, errors.New("connection refused"))
+	assert.Equal(t, CircuitOpen, b.State())
+}
+
+func TestCircuitBreaker_ProbeOutlivesCaller(t *testing.T) {
+	release := make(chan struct{})
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/heartbeats" {
+			<-release
+		}
+		w.Write([]byte(`{"wallets":[{"walletId":"1"}]}`))
+	})
+	defer teardown()
+	defer close(release)
+
+	closed := make(chan struct{})
+	breaker := &CircuitBreaker{
+		MinRequests: 1,
+		OnStateChange: func(from, to CircuitState) {
+			if to == CircuitClosed {
+				close(closed)
+			}
+		},
+		now: func() time.Time { return time.Unix(0, 0) },
+	}
+	breaker.record(nil, errors.New("connection refused"))
+	breaker.openedAt = time.Unix(0, 0).Add(-time.Hour)
+	client.CircuitBreaker = breaker
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for breaker.State() != CircuitHalfOpen {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+	_, _, err := client.Wallet.Get(ctx, "1")
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, CircuitHalfOpen, breaker.State())
+
+	release <- struct{}{}
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("the probe did not close the circuit")
+	}
+}
+
+func TestCircuitBreaker_ProbeTimeout(t *testing.T) {
+	breaker := &CircuitBreaker{
+		MinRequests:  1,
+		ProbeTimeout: 10 * time.Millisecond,
+		now:          func() time.Time { return time.Unix(0, 0) },
+	}
+	breaker.record(nil, errors.New("connection refused"))
+	breaker.openedAt = time.Unix(0, 0).Add(-time.Hour)
+
+	err := breaker.allow(context.Background(), func(ctx context.Context) bool {
+		<-ctx.Done()
+		return false
+	})
+	assert.True(t, errors.Is(err, ErrCircuitOpen))
+	assert.Equal(t, CircuitOpen, breaker.State())
+}
@@ -1,5 +1,11 @@
 package treezor
 
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
 // Currency is a string that contains the ISO 4217 currency code.
 type Currency string
 
@@ -147,3 +153,69 @@ const (
 	ZAR Currency = "ZAR" // South African Rand
 	ZMW Currency = "ZMW" // Zambian Kwacha
 )
+
+// ErrInvalidCurrency is returned when a currency is not a known ISO 4217 code.
+var ErrInvalidCurrency = errors.New("invalid currency")
+
+// iso4217Numeric indexes iso4217 by numeric code.
+var iso4217Numeric = func() map[string]Currency {
+	m := make(map[string]Currency, len(iso4217))
+	for c, info := range iso4217 {
+		m[info.numeric] = c
+	}
+	return m
+}()
+
+// ParseCurrency returns the currency matching s, which can either be an
+// alphabetic ISO 4217 code (case insensitive) such as "EUR", or a numeric one
+// such as "978".
+func ParseCurrency(s string) (Currency, error) {
+	s = strings.TrimSpace(s)
+	if c, ok := iso4217Numeric[s]; ok {
+		return c, nil
+	}
+	c := Currency(strings.ToUpper(s))
+	if !c.Valid() {
+		return "", errors.Wrapf(ErrInvalidCurrency, "%q", s)
+	}
+	return c, nil
+}
+
+// toCurrency converts a currency code as sent by the API, which may be numeric,
+// to a Currency. Unknown codes are returned unchanged.
+func toCurrency(s string) Currency {
+	if c, err := ParseCurrency(s); err == nil {
+		return c
+	}
+	return Currency(s)
+}
+
+// Valid reports whether c is a known ISO 4217 code.
+func (c Currency) Valid() bool {
+	_, ok := iso4217[c]
+	return ok
+}
+
+// MinorUnits returns the number of decimals of the minor unit of c, e.g. 2
+// for EUR, 0 for JPY and 3 for BHD. It returns -1 if c is not valid.
+func (c Currency) MinorUnits() int {
+	if info, ok := iso4217[c]; ok {
+		return info.minorUnits
+	}
+	return -1
+}
+
+// NumericCode returns the ISO 4217 numeric code of c, e.g. "978" for EUR.
+// It returns an empty string if c is not valid.
+func (c Currency) NumericCode() string {
+	return iso4217[c].numeric
+}
+
+// validate returns an error wrapping ErrInvalidCurrency if c is not a known
+// ISO 4217 code.
+func (c Currency) validate() error {
+	if !c.Valid() {
+		return errors.Wrapf(ErrInvalidCurrency, "%q", string(c))
+	}
+	return nil
+}
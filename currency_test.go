@@ -0,0 +1,64 @@
+package treezor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCurrency(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		for s, expected := range map[string]Currency{
+			"EUR": EUR,
+			"eur": EUR,
+			"978": EUR,
+			"392": JPY,
+			"BHD": Currency("BHD"),
+		} {
+			c, err := ParseCurrency(s)
+			assert.Nil(t, err, s)
+			assert.Equal(t, expected, c, s)
+		}
+	})
+	t.Run("Error unknown currency", func(t *testing.T) {
+		_, err := ParseCurrency("XYZ")
+		assert.True(t, errors.Is(err, ErrInvalidCurrency))
+	})
+}
+
+func TestCurrency_Metadata(t *testing.T) {
+	assert.Equal(t, 2, EUR.MinorUnits())
+	assert.Equal(t, 0, JPY.MinorUnits())
+	assert.Equal(t, 3, Currency("BHD").MinorUnits())
+	assert.Equal(t, -1, Currency("XYZ").MinorUnits())
+	assert.Equal(t, "978", EUR.NumericCode())
+	assert.Equal(t, "008", ALL.NumericCode())
+	assert.True(t, USD.Valid())
+	assert.False(t, Currency("").Valid())
+}
+
+func TestCurrency_MoneyScale(t *testing.T) {
+	m, err := ParseMoney("1.234", Currency("BHD"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1234), m.Amount)
+	assert.Equal(t, "1500", NewMoney(1500, JPY).Decimal())
+}
+
+func TestPayoutService_Create_InvalidCurrency(t *testing.T) {
+	client := NewClient(nil, false)
+	_, resp, err := client.Payout.Create(context.Background(), &Payout{Currency: "EURO"})
+	assert.Nil(t, resp)
+	assert.True(t, errors.Is(err, ErrInvalidCurrency))
+}
+
+func TestCreate_Nil(t *testing.T) {
+	client := NewClient(nil, false)
+	_, _, err := client.Payout.Create(context.Background(), nil)
+	assert.Error(t, err)
+	_, _, err = client.Transfer.Create(context.Background(), nil)
+	assert.Error(t, err)
+	_, _, err = client.Wallet.Create(context.Background(), nil)
+	assert.Error(t, err)
+}
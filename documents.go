@@ -92,12 +92,12 @@ type Document struct {
 	DocumentType      *string      `json:"documentType,omitempty"`
 	ClientID          *string      `json:"clientId,omitempty"`
 	UserID            *string      `json:"userId,omitempty"`
-	UserLastname      *string      `json:"userLastname,omitempty"`
-	UserFirstname     *string      `json:"userFirstname,omitempty"`
+	UserLastname      *string      `json:"userLastname,omitempty" treezor:"secret"`
+	UserFirstname     *string      `json:"userFirstname,omitempty" treezor:"secret"`
 	Filename          *string      `json:"fileName,omitempty"`
 	FileSize          *int64       `json:"fileSize,omitempty"`
 	Name              *string      `json:"name,omitempty"`
-	FileContentBase64 string       `json:"fileContentBase64,omitempty"`
+	FileContentBase64 string       `json:"fileContentBase64,omitempty" treezor:"secret"`
 	TemporaryURL      *string      `json:"temporaryUrl,omitempty"`
 	TemporaryURLThumb *string      `json:"temporaryUrlThumb,omitempty"`
 	CreatedDate       *string      `json:"createdDate,omitempty"`
@@ -113,7 +113,7 @@ func (s *DocumentService) Send(ctx context.Context, document *Document) (*Docume
 	req, _ := s.client.NewRequest(http.MethodPost, "documents", document)
 
 	d := new(DocumentResponse)
-	resp, err := s.client.Do(ctx, req, d)
+	resp, err := s.client.Do(WithOperation(ctx, "DocumentService.Send"), req, d)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -130,7 +130,7 @@ func (s *DocumentService) Get(ctx context.Context, documentID string) (*Document
 	req, _ := s.client.NewRequest(http.MethodGet, route, nil)
 
 	docs := new(DocumentResponse)
-	resp, err := s.client.Do(ctx, req, docs)
+	resp, err := s.client.Do(WithOperation(ctx, "DocumentService.Get"), req, docs)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -147,7 +147,7 @@ func (s *DocumentService) Delete(ctx context.Context, documentID string) (*http.
 	req, _ := s.client.NewRequest(http.MethodDelete, route, nil)
 
 	docs := new(DocumentResponse)
-	resp, err := s.client.Do(ctx, req, docs)
+	resp, err := s.client.Do(WithOperation(ctx, "DocumentService.Delete"), req, docs)
 	if err != nil {
 		return resp, errors.WithStack(err)
 	}
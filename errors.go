@@ -8,9 +8,68 @@ import (
 )
 
 const (
-	ErrCodeInsuficientFunds = 15030
+	// ErrCodeInsuficientFunds is kept for compatibility.
+	//
+	// Deprecated: use ErrCodeInsufficientFunds.
+	ErrCodeInsuficientFunds = ErrCodeInsufficientFunds
 )
 
+// ErrorCategory groups Treezor errors by their cause. An ErrorCategory can be
+// used as an errors.Is target to match any error of that category.
+//
+// The funds and card categories come from the error codes of the catalog.
+// The validation and auth categories come from the HTTP status code of the
+// response (400 and 422, 401 and 403), unless the catalog knows one of its
+// error codes.
+type ErrorCategory int
+
+// All the error categories.
+const (
+	CategoryUnknown ErrorCategory = iota
+	CategoryValidation
+	CategoryFunds
+	CategoryCard
+	CategoryAuth
+)
+
+var errorCategoryNames = map[ErrorCategory]string{
+	CategoryUnknown:    "unknown",
+	CategoryValidation: "validation",
+	CategoryFunds:      "funds",
+	CategoryCard:       "card",
+	CategoryAuth:       "auth",
+}
+
+func (c ErrorCategory) String() string {
+	return errorCategoryNames[c]
+}
+
+func (c ErrorCategory) Error() string {
+	return fmt.Sprintf("treezor %v error", c.String())
+}
+
+// ErrorCode describes a known Treezor error code. The catalog is generated
+// from errorcodes.json; see treezor_errorcodes.go. Retryable flags the codes
+// reporting a transient failure, which RetryPolicy retries; none of the
+// documented codes currently does, so retries rely on the HTTP status code.
+type ErrorCode struct {
+	Code      int
+	Name      string
+	Category  ErrorCategory
+	Retryable bool
+	Message   string
+}
+
+func (c *ErrorCode) Error() string {
+	return fmt.Sprintf("%v %v", c.Code, c.Message)
+}
+
+// LookupErrorCode returns the catalog entry of a Treezor error code.
+func LookupErrorCode(code int) (*ErrorCode, bool) {
+	c, ok := errorCodes[code]
+	return c, ok
+}
+
 // An ErrorResponse reports one or more errors caused by an API request.
 type ErrorResponse struct {
 	Response *http.Response // HTTP response that caused this error
@@ -23,6 +82,60 @@ func (r *ErrorResponse) Error() string {
 		r.Response.StatusCode, r.Errors)
 }
 
+// Unwrap returns the first error reported by the API, if any.
+func (r *ErrorResponse) Unwrap() error {
+	if len(r.Errors) == 0 {
+		return nil
+	}
+	return &r.Errors[0]
+}
+
+// Is reports whether any error reported by the API matches target, which can
+// be an *ErrorCode sentinel or an ErrorCategory. When none of the error codes
+// is known, an ErrorCategory target is matched against the category of the
+// HTTP status code.
+func (r *ErrorResponse) Is(target error) bool {
+	for i := range r.Errors {
+		if r.Errors[i].Is(target) {
+			return true
+		}
+	}
+	if c, ok := target.(ErrorCategory); ok {
+		return r.Category() == c
+	}
+	return false
+}
+
+// Category returns the category of the first known error code of the
+// response. If none is known, it falls back on the HTTP status code.
+func (r *ErrorResponse) Category() ErrorCategory {
+	for i := range r.Errors {
+		if c := r.Errors[i].Category(); c != CategoryUnknown {
+			return c
+		}
+	}
+	if r.Response != nil {
+		switch r.Response.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return CategoryAuth
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			return CategoryValidation
+		}
+	}
+	return CategoryUnknown
+}
+
+// Retryable reports whether one of the errors reported by the API is known
+// to be transient.
+func (r *ErrorResponse) Retryable() bool {
+	for i := range r.Errors {
+		if r.Errors[i].Retryable() {
+			return true
+		}
+	}
+	return false
+}
+
 // An Error reports more details on an individual error in an ErrorResponse.
 type Error struct {
 	Code    int    `json:"errorCode"`
@@ -33,6 +146,35 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("%v error caused because: %v", e.Code, e.Message)
 }
 
+// Is reports whether e has the same code as the *ErrorCode target or belongs
+// to the ErrorCategory target.
+func (e *Error) Is(target error) bool {
+	switch t := target.(type) {
+	case *ErrorCode:
+		return t.Code == e.Code
+	case ErrorCategory:
+		return e.Category() == t
+	}
+	return false
+}
+
+// Category returns the category of the error code, or CategoryUnknown if the
+// code is not in the catalog.
+func (e *Error) Category() ErrorCategory {
+	if c, ok := errorCodes[e.Code]; ok {
+		return c.Category
+	}
+	return CategoryUnknown
+}
+
+// Retryable reports whether the error code is known to be transient.
+func (e *Error) Retryable() bool {
+	if c, ok := errorCodes[e.Code]; ok {
+		return c.Retryable
+	}
+	return false
+}
+
 // CheckResponse checks the API response for errors, and returns them if
 // present. A response is considered an error if it has a status code outside
 // the 200 range.
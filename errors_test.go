@@ -0,0 +1,70 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorResponse_Is(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"errors":[{"errorCode":15030,"errorMessage":"Insufficient funds"}]}`))
+	})
+	defer teardown()
+
+	_, _, err := client.Payout.Create(context.Background(), &Payout{Currency: EUR})
+
+	t.Run("Success sentinel through stack", func(t *testing.T) {
+		assert.True(t, errors.Is(err, ErrInsufficientFunds))
+		assert.False(t, errors.Is(err, ErrCardBlocked))
+	})
+	t.Run("Success category", func(t *testing.T) {
+		assert.True(t, errors.Is(err, CategoryFunds))
+		assert.False(t, errors.Is(err, CategoryCard))
+	})
+	t.Run("Success as Error", func(t *testing.T) {
+		var e *Error
+		assert.True(t, errors.As(err, &e))
+		assert.Equal(t, ErrCodeInsufficientFunds, e.Code)
+	})
+}
+
+func TestErrorResponse_Category(t *testing.T) {
+	t.Run("Success fallback on status code", func(t *testing.T) {
+		r := &ErrorResponse{Response: &http.Response{StatusCode: http.StatusUnauthorized}}
+		assert.Equal(t, CategoryAuth, r.Category())
+	})
+	t.Run("Success unknown code", func(t *testing.T) {
+		r := &ErrorResponse{Response: &http.Response{StatusCode: http.StatusInternalServerError}, Errors: []Error{{Code: 1}}}
+		assert.Equal(t, CategoryUnknown, r.Category())
+	})
+}
+
+func TestErrorResponse_Is_Category(t *testing.T) {
+	r := &ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusBadRequest},
+		Errors:   []Error{{Code: ErrCodeInsufficientFunds}, {Code: ErrCodeCardBlocked}},
+	}
+	t.Run("Success any error", func(t *testing.T) {
+		assert.True(t, errors.Is(r, CategoryFunds))
+		assert.True(t, errors.Is(r, CategoryCard))
+	})
+	t.Run("Success no status fallback with known codes", func(t *testing.T) {
+		assert.False(t, errors.Is(r, CategoryValidation))
+	})
+	t.Run("Success status fallback", func(t *testing.T) {
+		r := &ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden}, Errors: []Error{{Code: 1}}}
+		assert.True(t, errors.Is(r, CategoryAuth))
+	})
+}
+
+func TestErrorCodes(t *testing.T) {
+	for code, c := range errorCodes {
+		assert.Equal(t, code, c.Code)
+		assert.NotEqual(t, CategoryUnknown, c.Category, "error code %v has no category", code)
+	}
+}
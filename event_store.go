@@ -0,0 +1,471 @@
+package treezor
+
+import (
+	"bufio"
+	"container/list"
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrEventNotFound is returned by an EventStore when no record exists for an
+// event ID.
+var ErrEventNotFound = errors.New("event not found")
+
+// EventStatus is the outcome of the processing of a webhook event.
+type EventStatus string
+
+// List of EventStatus.
+const (
+	EventProcessing EventStatus = "processing"
+	EventProcessed  EventStatus = "processed"
+	EventFailed     EventStatus = "failed"
+)
+
+// eventClaimLease is how long an event claimed by HandleOnce is considered
+// being processed. Past it, the claim is assumed to be left over by a crashed
+// process and the event can be claimed again.
+const eventClaimLease = 5 * time.Minute
+
+// EventRecord is the processing record of a webhook event kept by an
+// EventStore.
+type EventRecord struct {
+	Event       *Event      `json:"event"`
+	Status      EventStatus `json:"status"`
+	Error       string      `json:"error,omitempty"`
+	Attempts    int         `json:"attempts"`
+	ReceivedAt  time.Time   `json:"receivedAt"`
+	ClaimedAt   time.Time   `json:"claimedAt"`
+	ProcessedAt time.Time   `json:"processedAt"`
+}
+
+// EventStore keeps the processing records of webhook events, keyed by
+// Event.ID, so that events redelivered by Treezor are only processed once and
+// stored events can be replayed.
+//
+// Implementations must be safe for concurrent use.
+type EventStore interface {
+	// Get returns the record of the event with the given ID, or
+	// ErrEventNotFound.
+	Get(ctx context.Context, id string) (*EventRecord, error)
+
+	// Put creates or replaces the record of rec.Event.
+	Put(ctx context.Context, rec *EventRecord) error
+
+	// Claim atomically marks evt as being processed, unless its record shows
+	// it processed or claimed less than lease ago. It returns the claimed
+	// record and true, or the current record and false.
+	Claim(ctx context.Context, evt *Event, lease time.Duration) (*EventRecord, bool, error)
+
+	// Reclaim atomically marks the stored event with the given ID as being
+	// processed again, whatever its outcome, unless it is claimed less than
+	// lease ago. It returns the claimed record and true, the current record
+	// and false, or ErrEventNotFound.
+	Reclaim(ctx context.Context, id string, lease time.Duration) (*EventRecord, bool, error)
+
+	// List returns the stored records, oldest first.
+	List(ctx context.Context) ([]*EventRecord, error)
+}
+
+// HandleOnce calls h for evt unless the store holds a record of evt already
+// processed successfully, and records the outcome of h in the store.
+// It reports whether h was called.
+//
+// The event is claimed in the store before h is called, so that concurrent
+// redeliveries of an event are not handled twice. Events without ID are
+// always handled and never stored. A failed event is handled again when
+// redelivered.
+//
+// Example usage:
+//
+//	evt, err := treezor.ValidatePayload(r, webhookSecretKey)
+//	if err != nil { ... }
+//	_, err = treezor.HandleOnce(ctx, store, evt, func(ctx context.Context, evt *treezor.Event) error {
+//		// Process event...
+//		return nil
+//	})
+func HandleOnce(ctx context.Context, store EventStore, evt *Event, h func(ctx context.Context, evt *Event) error) (bool, error) {
+	return handleOnce(ctx, store, evt, eventClaimLease, h)
+}
+
+// handleOnce is HandleOnce with a custom claim lease.
+func handleOnce(ctx context.Context, store EventStore, evt *Event, lease time.Duration, h func(ctx context.Context, evt *Event) error) (bool, error) {
+	id := evt.GetID()
+	if store == nil || id == "" {
+		return true, h(ctx, evt)
+	}
+
+	rec, claimed, err := store.Claim(ctx, evt, lease)
+	if err != nil || !claimed {
+		return false, err
+	}
+	return true, runEventRecord(ctx, store, rec, h)
+}
+
+// claimEventRecord returns the record claiming evt at now, given the current
+// record cur of evt, if any. It returns false if evt cannot be claimed.
+// A processed event can only be claimed again when replay is true.
+func claimEventRecord(cur *EventRecord, evt *Event, lease time.Duration, now time.Time, replay bool) (*EventRecord, bool) {
+	if cur == nil {
+		return &EventRecord{Event: evt, Status: EventProcessing, ReceivedAt: now, ClaimedAt: now}, true
+	}
+	switch {
+	case cur.Status == EventProcessed && !replay:
+		return cur, false
+	case cur.Status == EventProcessing && now.Sub(cur.ClaimedAt) < lease:
+		return cur, false
+	}
+	rec := *cur
+	rec.Event, rec.Status, rec.ClaimedAt = evt, EventProcessing, now
+	return &rec, true
+}
+
+// runEventRecord calls h for the event of rec and stores the outcome.
+// The error of h takes precedence over the error of the store.
+func runEventRecord(ctx context.Context, store EventStore, rec *EventRecord, h func(ctx context.Context, evt *Event) error) error {
+	herr := h(ctx, rec.Event)
+	rec.Attempts++
+	rec.ProcessedAt = time.Now()
+	rec.Status, rec.Error = EventProcessed, ""
+	if herr != nil {
+		rec.Status, rec.Error = EventFailed, herr.Error()
+	}
+	if err := store.Put(ctx, rec); err != nil && herr == nil {
+		return err
+	}
+	return herr
+}
+
+// MemoryEventStore is an EventStore holding up to a fixed number of records
+// in memory. When full, the least recently used record is evicted.
+type MemoryEventStore struct {
+	capacity int
+
+	mu      sync.Mutex
+	ll      *list.List
+	records map[string]*list.Element
+}
+
+// NewMemoryEventStore returns a MemoryEventStore holding up to capacity
+// records. A capacity lower than 1 means no limit.
+func NewMemoryEventStore(capacity int) *MemoryEventStore {
+	return &MemoryEventStore{
+		capacity: capacity,
+		ll:       list.New(),
+		records:  map[string]*list.Element{},
+	}
+}
+
+// Get implements the EventStore interface.
+func (s *MemoryEventStore) Get(ctx context.Context, id string) (*EventRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.records[id]
+	if !ok {
+		return nil, errors.Wrap(ErrEventNotFound, id)
+	}
+	s.ll.MoveToFront(e)
+	rec := *e.Value.(*EventRecord)
+	return &rec, nil
+}
+
+// Put implements the EventStore interface.
+func (s *MemoryEventStore) Put(ctx context.Context, rec *EventRecord) error {
+	id := rec.Event.GetID()
+	if id == "" {
+		return errors.New("event has no ID")
+	}
+	cp := *rec
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(id, &cp)
+	return nil
+}
+
+// put stores rec, evicting the least recently used record if the store is
+// full. It must be called with s.mu held.
+func (s *MemoryEventStore) put(id string, rec *EventRecord) {
+	if e, ok := s.records[id]; ok {
+		e.Value = rec
+		s.ll.MoveToFront(e)
+		return
+	}
+	s.records[id] = s.ll.PushFront(rec)
+	if s.capacity > 0 && s.ll.Len() > s.capacity {
+		oldest := s.ll.Back()
+		s.ll.Remove(oldest)
+		delete(s.records, oldest.Value.(*EventRecord).Event.GetID())
+	}
+}
+
+// Claim implements the EventStore interface.
+func (s *MemoryEventStore) Claim(ctx context.Context, evt *Event, lease time.Duration) (*EventRecord, bool, error) {
+	id := evt.GetID()
+	if id == "" {
+		return nil, false, errors.New("event has no ID")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var cur *EventRecord
+	if e, ok := s.records[id]; ok {
+		cur = e.Value.(*EventRecord)
+	}
+	rec, ok := claimEventRecord(cur, evt, lease, time.Now(), false)
+	if ok {
+		s.put(id, rec)
+	}
+	cp := *rec
+	return &cp, ok, nil
+}
+
+// Reclaim implements the EventStore interface.
+func (s *MemoryEventStore) Reclaim(ctx context.Context, id string, lease time.Duration) (*EventRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.records[id]
+	if !ok {
+		return nil, false, errors.Wrap(ErrEventNotFound, id)
+	}
+	cur := e.Value.(*EventRecord)
+	rec, ok := claimEventRecord(cur, cur.Event, lease, time.Now(), true)
+	if ok {
+		s.put(id, rec)
+	}
+	cp := *rec
+	return &cp, ok, nil
+}
+
+// List implements the EventStore interface.
+func (s *MemoryEventStore) List(ctx context.Context) ([]*EventRecord, error) {
+	s.mu.Lock()
+	recs := make([]*EventRecord, 0, s.ll.Len())
+	for e := s.ll.Front(); e != nil; e = e.Next() {
+		rec := *e.Value.(*EventRecord)
+		recs = append(recs, &rec)
+	}
+	s.mu.Unlock()
+	sortEventRecords(recs)
+	return recs, nil
+}
+
+// FileEventStore is an EventStore persisting records in a file, as one JSON
+// record per line. The file is append-only: each Put appends the new version
+// of a record, and the last version wins when the file is loaded. Compact
+// rewrites the file with the current version of each record only.
+//
+// Records are stored in plain text, including the raw event payloads which
+// hold personal data of the end users (names, IBANs, addresses...). They are
+// kept as received so that they can be replayed: the file is created with
+// mode 0600 and should live on access-restricted, encrypted storage.
+type FileEventStore struct {
+	path string
+
+	mu      sync.Mutex
+	f       *os.File
+	records map[string]*EventRecord
+}
+
+// NewFileEventStore opens the FileEventStore at path, creating the file if
+// needed, and loads its records.
+func NewFileEventStore(path string) (*FileEventStore, error) {
+	s := &FileEventStore{path: path, records: map[string]*EventRecord{}}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	s.f = f
+	return s, nil
+}
+
+func (s *FileEventStore) load() error {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; sc.Scan(); line++ {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		rec := &EventRecord{}
+		if err := json.Unmarshal(sc.Bytes(), rec); err != nil {
+			return errors.Wrapf(err, "%s:%d", s.path, line)
+		}
+		s.records[rec.Event.GetID()] = rec
+	}
+	return errors.WithStack(sc.Err())
+}
+
+// Get implements the EventStore interface.
+func (s *FileEventStore) Get(ctx context.Context, id string) (*EventRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.records[id]
+	if !ok {
+		return nil, errors.Wrap(ErrEventNotFound, id)
+	}
+	cp := *rec
+	return &cp, nil
+}
+
+// Put implements the EventStore interface. The record is synced to disk
+// before Put returns.
+func (s *FileEventStore) Put(ctx context.Context, rec *EventRecord) error {
+	id := rec.Event.GetID()
+	if id == "" {
+		return errors.New("event has no ID")
+	}
+	cp := *rec
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.put(id, &cp)
+}
+
+// put appends rec to the file and stores it. It must be called with s.mu
+// held.
+func (s *FileEventStore) put(id string, rec *EventRecord) error {
+	if s.f == nil {
+		return errors.New("event store is closed")
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if _, err := s.f.Write(append(data, '\n')); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := s.f.Sync(); err != nil {
+		return errors.WithStack(err)
+	}
+	s.records[id] = rec
+	return nil
+}
+
+// Claim implements the EventStore interface. The claim is synced to disk
+// before Claim returns.
+func (s *FileEventStore) Claim(ctx context.Context, evt *Event, lease time.Duration) (*EventRecord, bool, error) {
+	id := evt.GetID()
+	if id == "" {
+		return nil, false, errors.New("event has no ID")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := claimEventRecord(s.records[id], evt, lease, time.Now(), false)
+	if ok {
+		if err := s.put(id, rec); err != nil {
+			return nil, false, err
+		}
+	}
+	cp := *rec
+	return &cp, ok, nil
+}
+
+// Reclaim implements the EventStore interface. The claim is synced to disk
+// before Reclaim returns.
+func (s *FileEventStore) Reclaim(ctx context.Context, id string, lease time.Duration) (*EventRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, ok := s.records[id]
+	if !ok {
+		return nil, false, errors.Wrap(ErrEventNotFound, id)
+	}
+	rec, ok := claimEventRecord(cur, cur.Event, lease, time.Now(), true)
+	if ok {
+		if err := s.put(id, rec); err != nil {
+			return nil, false, err
+		}
+	}
+	cp := *rec
+	return &cp, ok, nil
+}
+
+// List implements the EventStore interface.
+func (s *FileEventStore) List(ctx context.Context) ([]*EventRecord, error) {
+	s.mu.Lock()
+	recs := make([]*EventRecord, 0, len(s.records))
+	for _, rec := range s.records {
+		cp := *rec
+		recs = append(recs, &cp)
+	}
+	s.mu.Unlock()
+	sortEventRecords(recs)
+	return recs, nil
+}
+
+// Compact rewrites the file with the current version of each record only.
+func (s *FileEventStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return errors.New("event store is closed")
+	}
+
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, rec := range s.records {
+		if err := enc.Encode(rec); err != nil {
+			f.Close()
+			return errors.WithStack(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return errors.WithStack(err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.WithStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// The old file descriptor points to the replaced file.
+	s.f.Close()
+	s.f, err = os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, 0600)
+	return errors.WithStack(err)
+}
+
+// Close closes the underlying file.
+func (s *FileEventStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return errors.WithStack(err)
+}
+
+func sortEventRecords(recs []*EventRecord) {
+	sort.SliceStable(recs, func(i, j int) bool {
+		return recs[i].ReceivedAt.Before(recs[j].ReceivedAt)
+	})
+}
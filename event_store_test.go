@@ -0,0 +1,217 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryEventStore(t *testing.T) {
+	ctx := context.Background()
+	t.Run("Success get and put", func(t *testing.T) {
+		s := NewMemoryEventStore(0)
+		_, err := s.Get(ctx, "1")
+		assert.True(t, errors.Is(err, ErrEventNotFound))
+
+		assert.Nil(t, s.Put(ctx, &EventRecord{Event: &Event{ID: String("1")}, Status: EventProcessed}))
+		rec, err := s.Get(ctx, "1")
+		assert.Nil(t, err)
+		assert.Equal(t, EventProcessed, rec.Status)
+	})
+	t.Run("Success LRU eviction", func(t *testing.T) {
+		s := NewMemoryEventStore(2)
+		for _, id := range []string{"1", "2"} {
+			assert.Nil(t, s.Put(ctx, &EventRecord{Event: &Event{ID: String(id)}}))
+		}
+		_, _ = s.Get(ctx, "1")
+		assert.Nil(t, s.Put(ctx, &EventRecord{Event: &Event{ID: String("3")}}))
+
+		_, err := s.Get(ctx, "2")
+		assert.True(t, errors.Is(err, ErrEventNotFound))
+		_, err = s.Get(ctx, "1")
+		assert.Nil(t, err)
+	})
+	t.Run("Error event without ID", func(t *testing.T) {
+		assert.NotNil(t, NewMemoryEventStore(0).Put(ctx, &EventRecord{Event: &Event{}}))
+	})
+}
+
+func TestFileEventStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+
+	s, err := NewFileEventStore(path)
+	assert.Nil(t, err)
+	assert.Nil(t, s.Put(ctx, &EventRecord{Event: &Event{ID: String("1")}, Status: EventFailed, Error: "boom"}))
+	assert.Nil(t, s.Put(ctx, &EventRecord{Event: &Event{ID: String("2")}, Status: EventProcessed}))
+	assert.Nil(t, s.Put(ctx, &EventRecord{Event: &Event{ID: String("1")}, Status: EventProcessed}))
+	assert.Nil(t, s.Compact())
+	assert.Nil(t, s.Put(ctx, &EventRecord{Event: &Event{ID: String("3")}, Status: EventFailed}))
+	assert.Nil(t, s.Close())
+
+	s, err = NewFileEventStore(path)
+	assert.Nil(t, err)
+	defer s.Close()
+	recs, err := s.List(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, recs, 3)
+	rec, err := s.Get(ctx, "1")
+	assert.Nil(t, err)
+	assert.Equal(t, EventProcessed, rec.Status)
+	assert.Equal(t, "", rec.Error)
+}
+
+func TestHandleOnce_ConcurrentRedeliveries(t *testing.T) {
+	fileStore, err := NewFileEventStore(filepath.Join(t.TempDir(), "events.jsonl"))
+	assert.Nil(t, err)
+	defer fileStore.Close()
+
+	for name, store := range map[string]EventStore{
+		"memory": NewMemoryEventStore(0),
+		"file":   fileStore,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			h := func(ctx context.Context, evt *Event) error {
+				atomic.AddInt32(&calls, 1)
+				time.Sleep(10 * time.Millisecond)
+				return nil
+			}
+			var wg sync.WaitGroup
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := HandleOnce(context.Background(), store, &Event{ID: String("1")}, h)
+					assert.Nil(t, err)
+				}()
+			}
+			wg.Wait()
+			assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+			rec, err := store.Get(context.Background(), "1")
+			assert.Nil(t, err)
+			assert.Equal(t, EventProcessed, rec.Status)
+			assert.Equal(t, 1, rec.Attempts)
+		})
+	}
+}
+
+func TestClaimEventRecord(t *testing.T) {
+	now := time.Now()
+	evt := &Event{ID: String("1")}
+
+	rec, ok := claimEventRecord(nil, evt, time.Minute, now, false)
+	assert.True(t, ok)
+	assert.Equal(t, EventProcessing, rec.Status)
+
+	_, ok = claimEventRecord(rec, evt, time.Minute, now.Add(time.Second), false)
+	assert.False(t, ok, "claimed by another delivery")
+	_, ok = claimEventRecord(rec, evt, time.Minute, now.Add(time.Second), true)
+	assert.False(t, ok, "replayed while claimed by another delivery")
+
+	rec, ok = claimEventRecord(rec, evt, time.Minute, now.Add(2*time.Minute), false)
+	assert.True(t, ok, "stale claim")
+	assert.Equal(t, now, rec.ReceivedAt)
+
+	_, ok = claimEventRecord(&EventRecord{Event: evt, Status: EventFailed}, evt, time.Minute, now, false)
+	assert.True(t, ok)
+	_, ok = claimEventRecord(&EventRecord{Event: evt, Status: EventProcessed}, evt, time.Minute, now, false)
+	assert.False(t, ok)
+	_, ok = claimEventRecord(&EventRecord{Event: evt, Status: EventProcessed}, evt, time.Minute, now, true)
+	assert.True(t, ok, "replayed")
+}
+
+func TestEventStore_Reclaim(t *testing.T) {
+	fileStore, err := NewFileEventStore(filepath.Join(t.TempDir(), "events.jsonl"))
+	assert.Nil(t, err)
+	defer fileStore.Close()
+
+	for name, store := range map[string]EventStore{
+		"memory": NewMemoryEventStore(0),
+		"file":   fileStore,
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			_, _, err := store.Reclaim(ctx, "1", time.Minute)
+			assert.True(t, errors.Is(err, ErrEventNotFound))
+
+			assert.Nil(t, store.Put(ctx, &EventRecord{Event: &Event{ID: String("1")}, Status: EventProcessed}))
+			rec, ok, err := store.Reclaim(ctx, "1", time.Minute)
+			assert.Nil(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, EventProcessing, rec.Status)
+
+			rec, ok, err = store.Reclaim(ctx, "1", time.Minute)
+			assert.Nil(t, err)
+			assert.False(t, ok, "claimed by the first Reclaim")
+			assert.Equal(t, EventProcessing, rec.Status)
+		})
+	}
+}
+
+func TestWebhookRouter_Store(t *testing.T) {
+	router := NewWebhookRouter(testWebhookSecret)
+	router.Store = NewMemoryEventStore(10)
+	calls, fail := 0, true
+	router.OnPayinUpdate(func(ctx context.Context, e *PayinUpdateEvent) error {
+		calls++
+		if fail {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	serve := func() int {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newWebhookRequest("payin.update", `{"payins":[{"payinId":"1"}]}`, testWebhookSecret))
+		return w.Code
+	}
+
+	t.Run("Failed event is handled again", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, serve())
+		assert.Equal(t, http.StatusInternalServerError, serve())
+		assert.Equal(t, 2, calls)
+		rec, err := router.Store.Get(context.Background(), "1")
+		assert.Nil(t, err)
+		assert.Equal(t, EventFailed, rec.Status)
+		assert.Equal(t, 2, rec.Attempts)
+	})
+	t.Run("Replay after fix", func(t *testing.T) {
+		fail = false
+		n, err := router.Replay(context.Background(), func(rec *EventRecord) bool {
+			return rec.Status == EventFailed
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("Processed event is skipped", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve())
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("Replay skips claimed event", func(t *testing.T) {
+		_, ok, err := router.Store.Reclaim(context.Background(), "1", time.Minute)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+
+		n, err := router.Replay(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("Replay reclaims stale claim", func(t *testing.T) {
+		router.ClaimLease = time.Nanosecond
+		n, err := router.Replay(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 4, calls)
+	})
+}
@@ -135,3 +135,139 @@ type KycLivenessUpdateEvent struct {
 type SepaSddrCoreRejectEvent struct {
 	SepaSddrResponse
 }
+
+type BalanceUpdateEvent struct {
+	BalanceResponse
+}
+
+type BankAccountCreateEvent struct {
+	BankAccountResponse
+}
+
+type BankAccountUpdateEvent struct {
+	BankAccountResponse
+}
+
+type BankAccountCancelEvent struct {
+	BankAccountResponse
+}
+
+type BeneficiaryCreateEvent struct {
+	BeneficiaryResponse
+}
+
+type BeneficiaryUpdateEvent struct {
+	BeneficiaryResponse
+}
+
+type CardDigitalizationUpdateEvent struct {
+	CardDigitalizationResponse
+}
+
+type CountryGroupCreateEvent struct {
+	CountryGroupResponse
+}
+
+type CountryGroupUpdateEvent struct {
+	CountryGroupResponse
+}
+
+type CountryGroupCancelEvent struct {
+	CountryGroupResponse
+}
+
+type DocumentCreateEvent struct {
+	DocumentResponse
+}
+
+type DocumentUpdateEvent struct {
+	DocumentResponse
+}
+
+type DocumentCancelEvent struct {
+	DocumentResponse
+}
+
+type MandateCreateEvent struct {
+	MandateResponse
+}
+
+type MandateSignEvent struct {
+	MandateResponse
+}
+
+type MandateCancelEvent struct {
+	MandateResponse
+}
+
+type MerchantIDGroupCreateEvent struct {
+	MerchantIDGroupResponse
+}
+
+type MerchantIDGroupUpdateEvent struct {
+	MerchantIDGroupResponse
+}
+
+type MerchantIDGroupCancelEvent struct {
+	MerchantIDGroupResponse
+}
+
+type MCCGroupCreateEvent struct {
+	MCCGroupResponse
+}
+
+type MCCGroupCancelEvent struct {
+	MCCGroupResponse
+}
+
+type MCCGroupUpdateEvent struct {
+	MCCGroupResponse
+}
+
+type OneClickCardCreateEvent struct {
+	OneClickCardResponse
+}
+
+type OneClickCardUpdateEvent struct {
+	OneClickCardResponse
+}
+
+type OneClickCardCancelEvent struct {
+	OneClickCardResponse
+}
+
+type PayinRefundCreateEvent struct {
+	PayinRefundResponse
+}
+
+type PayinRefundUpdateEvent struct {
+	PayinRefundResponse
+}
+
+type PayinRefundCancelEvent struct {
+	PayinRefundResponse
+}
+
+type SepaSctrReturnEvent struct {
+	SepaSctrResponse
+}
+
+type SepaSddrB2BRejectEvent struct {
+	SepaSddrResponse
+}
+
+type TransactionCreateEvent struct {
+	TransactionResponse
+}
+
+type TransferRefundCreateEvent struct {
+	TransferRefundResponse
+}
+
+type TransferRefundUpdateEvent struct {
+	TransferRefundResponse
+}
+
+type TransferRefundCancelEvent struct {
+	TransferRefundResponse
+}
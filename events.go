@@ -24,13 +24,45 @@ func (e Event) String() string {
 // ParsePayload parses the event payload. For recognized event types,
 // a value of the corresponding struct type will be returned.
 func (e *Event) ParsePayload() (payload interface{}, err error) {
-	switch e.GetType() {
+	if p := newPayload(e.GetType()); p != nil {
+		payload = p
+	}
+	err = json.Unmarshal(e.GetRawPayload(), &payload)
+	return payload, errors.WithStack(err)
+}
+
+// redactedPayload returns the payload of the event with its secret fields
+// masked, as by Redact. The fields of the payloads of unknown event types are
+// unknown: these payloads are replaced by Redacted as a whole.
+func (e *Event) redactedPayload() *json.RawMessage {
+	if e.RawPayload == nil {
+		return nil
+	}
+	raw := json.RawMessage(`"` + Redacted + `"`)
+	if payload := newPayload(e.GetType()); payload != nil && json.Unmarshal(*e.RawPayload, payload) == nil {
+		if data, err := json.Marshal(Redact(payload)); err == nil {
+			raw = data
+		}
+	}
+	return &raw
+}
+
+// newPayload returns a pointer to the payload struct of the event type t, or
+// nil if t is unknown.
+func newPayload(t string) (payload interface{}) {
+	switch t {
 	case "balance.update":
+		payload = &BalanceUpdateEvent{}
 	case "bankaccount.create":
+		payload = &BankAccountCreateEvent{}
 	case "bankaccount.update":
+		payload = &BankAccountUpdateEvent{}
 	case "bankaccount.cancel":
+		payload = &BankAccountCancelEvent{}
 	case "beneficiary.create":
+		payload = &BeneficiaryCreateEvent{}
 	case "beneficiary.update":
+		payload = &BeneficiaryUpdateEvent{}
 	case "card.requestphysical":
 		payload = &CardRequestPhysicalEvent{}
 	case "card.createvirtual":
@@ -58,24 +90,45 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 	case "card.lockunlock":
 		payload = &CardLockUnlockEvent{}
 	case "cardDigitalization.update":
+		payload = &CardDigitalizationUpdateEvent{}
 	case "cardtransaction.create":
 		payload = &CardTransactionCreateEvent{}
 	case "countryGroup.create":
+		payload = &CountryGroupCreateEvent{}
 	case "countryGroup.update":
+		payload = &CountryGroupUpdateEvent{}
 	case "countryGroup.cancel":
+		payload = &CountryGroupCancelEvent{}
 	case "document.create":
+		payload = &DocumentCreateEvent{}
 	case "document.update":
+		payload = &DocumentUpdateEvent{}
 	case "document.cancel":
+		payload = &DocumentCancelEvent{}
 	case "mandate.create":
+		payload = &MandateCreateEvent{}
 	case "mandate.sign":
+		payload = &MandateSignEvent{}
 	case "mandate.cancel":
+		payload = &MandateCancelEvent{}
 	case "merchantIdGroup.create":
+		payload = &MerchantIDGroupCreateEvent{}
+	case "merchantIdGroup.update":
+		payload = &MerchantIDGroupUpdateEvent{}
+	case "merchantIdGroup.cancel":
+		payload = &MerchantIDGroupCancelEvent{}
 	case "mccGroup.create":
+		payload = &MCCGroupCreateEvent{}
 	case "mccGroup.cancel":
+		payload = &MCCGroupCancelEvent{}
 	case "mccGroup.update":
+		payload = &MCCGroupUpdateEvent{}
 	case "oneclickcard.create":
+		payload = &OneClickCardCreateEvent{}
 	case "oneclickcard.update":
+		payload = &OneClickCardUpdateEvent{}
 	case "oneclickcard.cancel":
+		payload = &OneClickCardCancelEvent{}
 	case "payin.create":
 		payload = &PayinCreateEvent{}
 	case "payin.update":
@@ -83,8 +136,11 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 	case "payin.cancel":
 		payload = &PayinCancelEvent{}
 	case "payinrefund.create":
+		payload = &PayinRefundCreateEvent{}
 	case "payinrefund.update":
+		payload = &PayinRefundUpdateEvent{}
 	case "payinrefund.cancel":
+		payload = &PayinRefundCancelEvent{}
 	case "payout.create":
 		payload = &PayoutCreateEvent{}
 	case "payout.update":
@@ -92,17 +148,25 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 	case "payout.cancel":
 		payload = &PayoutCancelEvent{}
 	case "sepa.return_sctr":
+		payload = &SepaSctrReturnEvent{}
 	case "sepa.reject_sddr_core":
 		payload = &SepaSddrCoreRejectEvent{}
 	case "sepa.reject_sddr_b2b":
+		payload = &SepaSddrB2BRejectEvent{}
 	case "transaction.create":
+		payload = &TransactionCreateEvent{}
 	case "transfer.create":
+		payload = &TransferCreateEvent{}
 	case "transfer.update":
 		payload = &TransferUpdateEvent{}
 	case "transfer.cancel":
+		payload = &TransferCancelEvent{}
 	case "transferrefund.create":
+		payload = &TransferRefundCreateEvent{}
 	case "transferrefund.update":
+		payload = &TransferRefundUpdateEvent{}
 	case "transferrefund.cancel":
+		payload = &TransferRefundCancelEvent{}
 	case "user.create":
 		payload = &UserCreateEvent{}
 	case "user.update":
@@ -124,6 +188,5 @@ func (e *Event) ParsePayload() (payload interface{}, err error) {
 	case "kycliveness.update":
 		payload = &KycLivenessUpdateEvent{}
 	}
-	err = json.Unmarshal(e.GetRawPayload(), &payload)
-	return payload, errors.WithStack(err)
+	return payload
 }
@@ -0,0 +1,122 @@
+package treezor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func loadEventFixture(t *testing.T, eventType string) *Event {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "events", eventType+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	evt := &Event{}
+	if err := json.Unmarshal(data, evt); err != nil {
+		t.Fatal(err)
+	}
+	return evt
+}
+
+func TestEvent_ParsePayload(t *testing.T) {
+	for eventType, want := range map[string]interface{}{
+		"balance.update":            &BalanceUpdateEvent{},
+		"bankaccount.create":        &BankAccountCreateEvent{},
+		"bankaccount.update":        &BankAccountUpdateEvent{},
+		"bankaccount.cancel":        &BankAccountCancelEvent{},
+		"beneficiary.create":        &BeneficiaryCreateEvent{},
+		"beneficiary.update":        &BeneficiaryUpdateEvent{},
+		"cardDigitalization.update": &CardDigitalizationUpdateEvent{},
+		"countryGroup.create":       &CountryGroupCreateEvent{},
+		"countryGroup.update":       &CountryGroupUpdateEvent{},
+		"countryGroup.cancel":       &CountryGroupCancelEvent{},
+		"document.create":           &DocumentCreateEvent{},
+		"document.update":           &DocumentUpdateEvent{},
+		"document.cancel":           &DocumentCancelEvent{},
+		"mandate.create":            &MandateCreateEvent{},
+		"mandate.sign":              &MandateSignEvent{},
+		"mandate.cancel":            &MandateCancelEvent{},
+		"merchantIdGroup.create":    &MerchantIDGroupCreateEvent{},
+		"merchantIdGroup.update":    &MerchantIDGroupUpdateEvent{},
+		"merchantIdGroup.cancel":    &MerchantIDGroupCancelEvent{},
+		"mccGroup.create":           &MCCGroupCreateEvent{},
+		"mccGroup.cancel":           &MCCGroupCancelEvent{},
+		"mccGroup.update":           &MCCGroupUpdateEvent{},
+		"oneclickcard.create":       &OneClickCardCreateEvent{},
+		"oneclickcard.update":       &OneClickCardUpdateEvent{},
+		"oneclickcard.cancel":       &OneClickCardCancelEvent{},
+		"payinrefund.create":        &PayinRefundCreateEvent{},
+		"payinrefund.update":        &PayinRefundUpdateEvent{},
+		"payinrefund.cancel":        &PayinRefundCancelEvent{},
+		"sepa.return_sctr":          &SepaSctrReturnEvent{},
+		"sepa.reject_sddr_b2b":      &SepaSddrB2BRejectEvent{},
+		"transaction.create":        &TransactionCreateEvent{},
+		"transfer.create":           &TransferCreateEvent{},
+		"transfer.cancel":           &TransferCancelEvent{},
+		"transferrefund.create":     &TransferRefundCreateEvent{},
+		"transferrefund.update":     &TransferRefundUpdateEvent{},
+		"transferrefund.cancel":     &TransferRefundCancelEvent{},
+	} {
+		eventType, want := eventType, want
+		t.Run(eventType, func(t *testing.T) {
+			payload, err := loadEventFixture(t, eventType).ParsePayload()
+			assert.Nil(t, err)
+			assert.IsType(t, want, payload)
+
+			// Every payload holds a single non-empty list of objects.
+			list := reflect.ValueOf(payload).Elem().Field(0).Field(0)
+			assert.Equal(t, reflect.Slice, list.Kind())
+			assert.Equal(t, 1, list.Len())
+		})
+	}
+}
+
+func TestEvent_ParsePayload_Fields(t *testing.T) {
+	t.Run("Success mandate", func(t *testing.T) {
+		payload, err := loadEventFixture(t, "mandate.sign").ParsePayload()
+		assert.Nil(t, err)
+		m := payload.(*MandateSignEvent).Mandates[0]
+		assert.Equal(t, "16", m.GetMandateID())
+		assert.Equal(t, "UMR16", m.GetUniqueMandateReference())
+		assert.Equal(t, "2021-03-04", m.GetSignatureDate().String())
+	})
+	t.Run("Success transaction amounts", func(t *testing.T) {
+		payload, err := loadEventFixture(t, "transaction.create").ParsePayload()
+		assert.Nil(t, err)
+		tr := payload.(*TransactionCreateEvent).Transactions[0]
+		assert.Equal(t, NewMoney(1234, EUR), tr.AmountMoney())
+		assert.Equal(t, NewMoney(8766, EUR), tr.WalletDebitBalanceMoney())
+	})
+	t.Run("Success restriction group", func(t *testing.T) {
+		payload, err := loadEventFixture(t, "mccGroup.create").ParsePayload()
+		assert.Nil(t, err)
+		g := payload.(*MCCGroupCreateEvent).MCCGroups[0]
+		assert.Equal(t, []int64{7995, 7800}, g.MCC)
+		assert.False(t, g.GetIsWhitelist())
+		assert.Equal(t, RestrictionDeny, g.Mode())
+
+		g.SetMode(RestrictionAllow)
+		assert.True(t, g.GetIsWhitelist())
+	})
+	t.Run("Success unknown event", func(t *testing.T) {
+		raw := json.RawMessage(`{"foo":"bar"}`)
+		evt := &Event{Type: String("unknown.event"), RawPayload: &raw}
+		payload, err := evt.ParsePayload()
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"foo": "bar"}, payload)
+	})
+}
+
+func TestEvent_Fixtures(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "events", "*.json"))
+	assert.Nil(t, err)
+	for _, f := range files {
+		eventType := strings.TrimSuffix(filepath.Base(f), ".json")
+		assert.Equal(t, eventType, loadEventFixture(t, eventType).GetType(), f)
+	}
+}
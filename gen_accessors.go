@@ -14,7 +14,9 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 )
@@ -33,6 +35,17 @@ var (
 	// blacklistStruct lists structs to skip.
 	blacklistStruct = map[string]bool{
 		"Client": true,
+		// Operation and LogEntry describe a call in flight, not an API
+		// resource; an accessor would copy the http.Request by value.
+		"LogEntry":  true,
+		"Operation": true,
+		// SDK helpers holding configuration or results, not API resources.
+		// WebhookKey must not expose its secret through an accessor.
+		"ClientCredentialsTransport": true,
+		"EventRecord":                true,
+		"GetManyError":               true,
+		"WebhookKey":                 true,
+		"WebhookValidator":           true,
 	}
 )
 
@@ -96,6 +109,7 @@ func (t *templateData) processAST(f *ast.File) error {
 			if !ok {
 				continue
 			}
+			t.addMoneyFields(st, ts.Name.String())
 			for _, field := range st.Fields.List {
 				if len(field.Names) == 0 {
 					continue
@@ -136,7 +150,7 @@ func (t *templateData) processAST(f *ast.File) error {
 }
 
 func sourceFilter(fi os.FileInfo) bool {
-	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), fileSuffix)
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), fileSuffix) && !strings.HasPrefix(fi.Name(), "gen_")
 }
 
 func (t *templateData) dump() error {
@@ -281,6 +295,59 @@ func (t *templateData) addSelectorExpr(x *ast.SelectorExpr, receiverType, fieldN
 	}
 }
 
+// addMoneyFields adds Money accessors for the float64 amount fields tagged
+// with `money:"CurrencyField"` or `money:"CurrencyField,set"`, where
+// CurrencyField is the field of the same struct holding the currency.
+func (t *templateData) addMoneyFields(st *ast.StructType, receiverType string) {
+	fieldTypes := map[string]ast.Expr{}
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			fieldTypes[name.Name] = field.Type
+		}
+	}
+
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 || field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		money, ok := reflect.StructTag(tag).Lookup("money")
+		if !ok {
+			continue
+		}
+		fieldName := field.Names[0].String()
+		opts := strings.Split(money, ",")
+		currencyField := opts[0]
+
+		recv := strings.ToLower(receiverType[:1])
+		var currencyExpr string
+		settable := false
+		switch typ := fieldTypes[currencyField].(type) {
+		case *ast.Ident:
+			// Currency value field.
+			currencyExpr = fmt.Sprintf("%v.%v", recv, currencyField)
+			settable = typ.Name == "Currency"
+		case *ast.StarExpr:
+			// *string field holding the currency code, which may be numeric.
+			currencyExpr = fmt.Sprintf("toCurrency(%v.Get%v())", recv, currencyField)
+		default:
+			logf("addMoneyFields: type %q, field %q: unknown currency field %q; skipping.", receiverType, fieldName, currencyField)
+			continue
+		}
+
+		g := newGetter(receiverType, fieldName+"Money", "Money", "Money{}", false, false)
+		g.Money = true
+		g.AmountField = fieldName
+		g.CurrencyField = currencyField
+		g.CurrencyExpr = currencyExpr
+		g.MoneySetter = settable && len(opts) > 1 && opts[1] == "set"
+		t.Getters = append(t.Getters, g)
+	}
+}
+
 type templateData struct {
 	filename string
 	Package  string
@@ -297,6 +364,12 @@ type getter struct {
 	ZeroValue    string
 	NamedStruct  bool // Getter for named struct.
 	Slice        bool // Getter for slices.
+
+	Money         bool   // Money accessors of a float64 amount field.
+	MoneySetter   bool   // Also generate a Money setter.
+	AmountField   string // The float64 amount field.
+	CurrencyField string // The field holding the currency of the amount.
+	CurrencyExpr  string // The expression returning the currency of the amount.
 }
 
 type byName []*getter
@@ -316,7 +389,22 @@ import (
 )
 {{end}}
 {{range .Getters}}
-{{if .NamedStruct}}
+{{if .Money}}
+// {{.AmountField}}Money returns the {{.AmountField}} field as Money in the currency of {{.CurrencyField}}.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) {{.AmountField}}Money() Money {
+  if {{.ReceiverVar}} == nil {
+    return Money{}
+  }
+  return MoneyFromFloat({{.ReceiverVar}}.Get{{.AmountField}}(), {{.CurrencyExpr}})
+}
+{{if .MoneySetter}}
+// Set{{.AmountField}}Money sets the {{.AmountField}} and {{.CurrencyField}} fields from m.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Set{{.AmountField}}Money(m Money) {
+  {{.ReceiverVar}}.{{.AmountField}} = Float64(m.Float64())
+  {{.ReceiverVar}}.{{.CurrencyField}} = m.Currency
+}
+{{end}}
+{{else if .NamedStruct}}
 // Get{{.FieldName}} returns the {{.FieldName}} field.
 func ({{.ReceiverVar}} *{{.ReceiverType}}) Get{{.FieldName}}() *{{.FieldType}} {
   if {{.ReceiverVar}} != nil {
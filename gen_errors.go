@@ -0,0 +1,124 @@
+// +build ignore
+
+// gen-errors generates the catalog of Treezor error codes from errorcodes.json.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	inputFile  = "errorcodes.json"
+	outputFile = "treezor_errorcodes.go"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Funcs(template.FuncMap{
+		"category": categoryIdent,
+	}).Parse(source))
+
+	categories = map[string]string{
+		"validation": "CategoryValidation",
+		"funds":      "CategoryFunds",
+		"card":       "CategoryCard",
+		"auth":       "CategoryAuth",
+	}
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+type errorCode struct {
+	Code      int    `json:"code"`
+	Name      string `json:"name"`
+	Category  string `json:"category"`
+	Retryable bool   `json:"retryable"`
+	Message   string `json:"message"`
+}
+
+func categoryIdent(c string) string {
+	if ident, ok := categories[strings.ToLower(c)]; ok {
+		return ident
+	}
+	return "CategoryUnknown"
+}
+
+func main() {
+	flag.Parse()
+
+	data, err := ioutil.ReadFile(inputFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var codes []*errorCode
+	if err := json.Unmarshal(data, &codes); err != nil {
+		log.Fatal(err)
+	}
+
+	seen := map[int]bool{}
+	for _, c := range codes {
+		logf("Processing %d %v...", c.Code, c.Name)
+		if seen[c.Code] {
+			log.Fatalf("duplicate error code %d", c.Code)
+		}
+		seen[c.Code] = true
+		if _, ok := categories[strings.ToLower(c.Category)]; !ok {
+			log.Fatalf("error code %d has unknown category %q", c.Code, c.Category)
+		}
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i].Code < codes[j].Code })
+
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, codes); err != nil {
+		log.Fatal(err)
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	logf("Writing %v...", outputFile)
+	if err := ioutil.WriteFile(outputFile, clean, 0644); err != nil {
+		log.Fatal(err)
+	}
+	logf("Done.")
+}
+
+const source = `// Code generated by gen_errors; DO NOT EDIT.
+
+package treezor
+
+// Treezor error codes.
+const (
+{{- range .}}
+	ErrCode{{.Name}} = {{.Code}}
+{{- end}}
+)
+
+// Sentinel errors for Treezor error codes. They can be matched against
+// errors returned by the services with errors.Is.
+var (
+{{- range .}}
+	Err{{.Name}} = &ErrorCode{Code: ErrCode{{.Name}}, Name: {{printf "%q" .Name}}, Category: {{category .Category}}, Retryable: {{.Retryable}}, Message: {{printf "%q" .Message}}}
+{{- end}}
+)
+
+var errorCodes = map[int]*ErrorCode{
+{{- range .}}
+	ErrCode{{.Name}}: Err{{.Name}},
+{{- end}}
+}
+`
@@ -0,0 +1,350 @@
+//go:build ignore
+// +build ignore
+
+// gen-iterators generates an iterator and a ListAll helper for the List method
+// of each service whose options embed ListOptions.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+const (
+	fileSuffix    = "_iterators.go"
+	serviceSuffix = "Service"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	sourceTmpl = template.Must(template.New("source").Parse(source))
+
+	// idFields maps the items which have no field named after them, like
+	// UserID for User, to the field identifying them within a page.
+	idFields = map[string]string{
+		"Balance": "WalletID",
+	}
+
+	// nouns maps the items to the noun used in the doc comments, when it is
+	// not their name in lower case.
+	nouns = map[string]string{
+		"Payin":           "pay-in",
+		"Payout":          "pay-out",
+		"PayinRefund":     "pay-in refund",
+		"MCCGroup":        "MCC restriction group",
+		"CountryGroup":    "country restriction group",
+		"MerchantIDGroup": "merchant ID restriction group",
+	}
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for pkgName, pkg := range pkgs {
+		t := &templateData{
+			filename: pkgName + fileSuffix,
+			Package:  pkgName,
+			structs:  map[string]*ast.StructType{},
+		}
+		var lists []*ast.FuncDecl
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			lists = append(lists, t.processAST(f)...)
+		}
+		for _, decl := range lists {
+			if it := t.newIterator(decl); it != nil {
+				t.Iterators = append(t.Iterators, it)
+			}
+		}
+		sort.Slice(t.Iterators, func(i, j int) bool { return t.Iterators[i].Service < t.Iterators[j].Service })
+		if err := t.dump(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), fileSuffix) && !strings.HasPrefix(fi.Name(), "gen_")
+}
+
+// processAST records the structs of f and returns the List methods of its
+// services.
+func (t *templateData) processAST(f *ast.File) []*ast.FuncDecl {
+	var lists []*ast.FuncDecl
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				if st, ok := ts.Type.(*ast.StructType); ok {
+					t.structs[ts.Name.Name] = st
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || decl.Name.Name != "List" {
+				continue
+			}
+			if strings.HasSuffix(ident(decl.Recv.List[0].Type), serviceSuffix) {
+				lists = append(lists, decl)
+			}
+		}
+	}
+	return lists
+}
+
+// newIterator returns the iterator of the List method decl, or nil if it does
+// not take options embedding ListOptions.
+func (t *templateData) newIterator(decl *ast.FuncDecl) *iterator {
+	service := ident(decl.Recv.List[0].Type)
+	params, results := decl.Type.Params.List, decl.Type.Results.List
+	if len(params) != 2 || len(results) != 3 {
+		logf("%v.List: unknown signature; skipping.", service)
+		return nil
+	}
+	options, response := ident(params[1].Type), ident(results[0].Type)
+	if !t.embeds(options, "ListOptions") {
+		logf("%v.List: %v does not embed ListOptions; skipping.", service, options)
+		return nil
+	}
+
+	it := &iterator{Service: service, Options: options}
+	for _, field := range t.structs[response].Fields.List {
+		if at, ok := field.Type.(*ast.ArrayType); ok && len(field.Names) == 1 {
+			it.Field, it.Item = field.Names[0].Name, ident(at.Elt)
+			break
+		}
+	}
+	if it.Item == "" {
+		log.Fatalf("%v.List: no items in %v", service, response)
+	}
+
+	item := t.structs[it.Item]
+	idField := idFields[it.Item]
+	if idField == "" {
+		idField = it.Item + "ID"
+	}
+	for _, name := range []string{idField, "ID"} {
+		switch fieldType(item, name) {
+		case "string":
+			it.ID = "first.Get" + name + "()"
+		case "int64":
+			it.ID = "int64ID(first.Get" + name + "())"
+		case "json.Number":
+			it.ID = "first.Get" + name + "().String()"
+		default:
+			continue
+		}
+		break
+	}
+	if it.ID == "" {
+		log.Fatalf("%v.List: no ID field in %v", service, it.Item)
+	}
+	it.TotalRows = fieldType(item, "TotalRows") == "int64"
+
+	it.Noun = nouns[it.Item]
+	if it.Noun == "" {
+		it.Noun = lowerWords(it.Item)
+	}
+	it.Nouns = it.Noun + "s"
+	if strings.HasSuffix(it.Noun, "y") {
+		it.Nouns = strings.TrimSuffix(it.Noun, "y") + "ies"
+	}
+	logf("Iterator of %v.List over %v", service, it.Item)
+	return it
+}
+
+// embeds reports whether the struct name embeds the type embedded.
+func (t *templateData) embeds(name, embedded string) bool {
+	st, ok := t.structs[name]
+	if !ok {
+		return false
+	}
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 && ident(field.Type) == embedded {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldType returns the type pointed to by the field name of st, or "" if it
+// is not a pointer.
+func fieldType(st *ast.StructType, name string) string {
+	for _, field := range st.Fields.List {
+		for _, n := range field.Names {
+			if n.Name != name {
+				continue
+			}
+			star, ok := field.Type.(*ast.StarExpr)
+			if !ok {
+				return ""
+			}
+			if sel, ok := star.X.(*ast.SelectorExpr); ok {
+				return ident(sel.X) + "." + sel.Sel.Name
+			}
+			return ident(star.X)
+		}
+	}
+	return ""
+}
+
+// ident returns the name of the identifier expr, possibly behind a pointer.
+func ident(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if id, ok := expr.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// lowerWords turns a CamelCase name into lower case words.
+func lowerWords(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func (t *templateData) dump() error {
+	var buf bytes.Buffer
+	if err := sourceTmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logf("Writing %v...", t.filename)
+	return ioutil.WriteFile(t.filename, clean, 0644)
+}
+
+type templateData struct {
+	filename  string
+	Package   string
+	Iterators []*iterator
+
+	structs map[string]*ast.StructType
+}
+
+type iterator struct {
+	Service   string // Name of the service, e.g. CardService.
+	Options   string // Options of the List method, e.g. CardListOptions.
+	Field     string // Field of the List response holding the items, e.g. Cards.
+	Item      string // Type of the items, e.g. Card.
+	ID        string // Expression returning the ID of the first item.
+	TotalRows bool   // Whether the items report TotalRows.
+	Noun      string // Noun of an item in doc comments, e.g. card.
+	Nouns     string // Plural of Noun.
+}
+
+const source = `// Code generated by gen_iterators; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+  "context"
+
+  "github.com/pkg/errors"
+)
+{{range .Iterators}}
+// {{.Item}}Iterator walks all the {{.Nouns}} matching a {{.Service}}.List query,
+// fetching pages on demand.
+type {{.Item}}Iterator struct {
+  pageIterator
+  page  []*{{.Item}}
+  items []*{{.Item}}
+  cur   *{{.Item}}
+}
+
+// Iterate returns an iterator over all the {{.Nouns}} matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *{{.Service}}) Iterate(ctx context.Context, opt *{{.Options}}) *{{.Item}}Iterator {
+  o := new({{.Options}})
+  if opt != nil {
+    *o = *opt
+  }
+
+  it := new({{.Item}}Iterator)
+  it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+    r, _, err := s.List(ctx, o)
+    if err != nil {
+      return page{}, errors.WithStack(err)
+    }
+    it.page = r.{{.Field}}
+    if len(r.{{.Field}}) == 0 {
+      return page{}, nil
+    }
+    first := r.{{.Field}}[0]
+    return page{n: len(r.{{.Field}}), {{if .TotalRows}}totalRows: first.GetTotalRows(), {{end}}firstID: {{.ID}}}, nil
+  })
+  return it
+}
+
+// Next advances the iterator to the next {{.Noun}}. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *{{.Item}}Iterator) Next() bool {
+  for len(it.items) == 0 {
+    if !it.nextPage() {
+      return false
+    }
+    it.items, it.page = it.page, nil
+  }
+  if !it.take() {
+    return false
+  }
+  it.cur, it.items = it.items[0], it.items[1:]
+  return true
+}
+
+// {{.Item}} returns the current {{.Noun}}.
+func (it *{{.Item}}Iterator) {{.Item}}() *{{.Item}} {
+  return it.cur
+}
+
+// ListAll returns all the {{.Nouns}} matching opt, walking every page.
+func (s *{{.Service}}) ListAll(ctx context.Context, opt *{{.Options}}) ([]*{{.Item}}, error) {
+  var all []*{{.Item}}
+  it := s.Iterate(ctx, opt)
+  for it.Next() {
+    all = append(all, it.{{.Item}}())
+  }
+  return all, it.Err()
+}
+{{end}}
+`
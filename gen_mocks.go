@@ -0,0 +1,386 @@
+//go:build ignore
+// +build ignore
+
+// gen-mocks generates an interface for each service of the client and a mock
+// implementing it in the treezormock package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	interfacesFile  = "treezor_interfaces.go"
+	mocksFile       = "treezormock/treezormock.go"
+	importPath      = "github.com/tifo/treezor-sdk"
+	serviceSuffix   = "Service"
+	interfaceSuffix = "API"
+)
+
+var (
+	verbose = flag.Bool("v", false, "Print verbose log messages")
+
+	interfacesTmpl = template.Must(template.New("interfaces").Parse(interfacesSource))
+	mocksTmpl      = template.Must(template.New("mocks").Parse(mocksSource))
+
+	// importPaths maps the package names used in method signatures to their
+	// import path.
+	importPaths = map[string]string{
+		"context": "context",
+		"http":    "net/http",
+		"io":      "io",
+		"json":    "encoding/json",
+		"time":    "time",
+		"url":     "net/url",
+	}
+)
+
+func logf(fmt string, args ...interface{}) {
+	if *verbose {
+		log.Printf(fmt, args...)
+	}
+}
+
+func main() {
+	flag.Parse()
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, ".", sourceFilter, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for pkgName, pkg := range pkgs {
+		t := &templateData{
+			Package:         pkgName,
+			ImportPath:      importPath,
+			Imports:         map[string]string{},
+			MockImports:     map[string]string{},
+			services:        map[string]*service{},
+			declaredService: map[string]bool{},
+		}
+		for filename, f := range pkg.Files {
+			logf("Processing %v...", filename)
+			t.processAST(f)
+		}
+		for name, s := range t.services {
+			if !t.declaredService[name] {
+				continue
+			}
+			sort.Slice(s.Methods, func(i, j int) bool { return s.Methods[i].Name < s.Methods[j].Name })
+			t.Services = append(t.Services, s)
+		}
+		sort.Slice(t.Services, func(i, j int) bool { return t.Services[i].Name < t.Services[j].Name })
+
+		if err := dump(interfacesFile, interfacesTmpl, t); err != nil {
+			log.Fatal(err)
+		}
+		if err := dump(mocksFile, mocksTmpl, t); err != nil {
+			log.Fatal(err)
+		}
+	}
+	logf("Done.")
+}
+
+func sourceFilter(fi os.FileInfo) bool {
+	return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), "_interfaces.go") && !strings.HasPrefix(fi.Name(), "gen_")
+}
+
+func dump(filename string, tmpl *template.Template, t *templateData) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, t); err != nil {
+		return err
+	}
+	clean, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	logf("Writing %v...", filename)
+	return ioutil.WriteFile(filename, clean, 0644)
+}
+
+func (t *templateData) service(name string) *service {
+	s, ok := t.services[name]
+	if !ok {
+		s = &service{
+			Name:      name,
+			Interface: strings.TrimSuffix(name, serviceSuffix) + interfaceSuffix,
+		}
+		t.services[name] = s
+	}
+	return s
+}
+
+func (t *templateData) processAST(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if ok && ts.Name.IsExported() && strings.HasSuffix(ts.Name.Name, serviceSuffix) {
+					t.declaredService[ts.Name.Name] = true
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || !decl.Name.IsExported() {
+				continue
+			}
+			star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			recv, ok := star.X.(*ast.Ident)
+			if !ok || !recv.IsExported() || !strings.HasSuffix(recv.Name, serviceSuffix) {
+				continue
+			}
+			if returnsIterator(decl) {
+				// Iterators are concrete types built on the unexported
+				// pageIterator: a mock could only return nil, which panics
+				// on Next. Callers mock ListAll instead.
+				logf("Skipping iterator method %v.%v", recv.Name, decl.Name.Name)
+				continue
+			}
+			logf("Method %v.%v", recv.Name, decl.Name.Name)
+			s := t.service(recv.Name)
+			s.Methods = append(s.Methods, t.newMethod(decl))
+		}
+	}
+}
+
+// returnsIterator reports whether decl returns a *XIterator.
+func returnsIterator(decl *ast.FuncDecl) bool {
+	if decl.Type.Results == nil {
+		return false
+	}
+	for _, r := range decl.Type.Results.List {
+		star, ok := r.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		if id, ok := star.X.(*ast.Ident); ok && strings.HasSuffix(id.Name, "Iterator") {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *templateData) newMethod(decl *ast.FuncDecl) *method {
+	m := &method{Name: decl.Name.Name}
+	if decl.Doc != nil {
+		for _, line := range strings.Split(strings.TrimSpace(decl.Doc.Text()), "\n") {
+			m.Doc = append(m.Doc, strings.TrimRight("// "+line, " "))
+		}
+	}
+
+	var params, mockParams, args []string
+	for i, p := range flatten(decl.Type.Params) {
+		name := p.name
+		if name == "" || name == "_" || name == "m" {
+			name = fmt.Sprintf("p%d", i)
+		}
+		typ, mockTyp := t.typeString(p.typ, false), t.typeString(p.typ, true)
+		arg := name
+		if strings.HasPrefix(typ, "...") {
+			arg += "..."
+		}
+		params = append(params, name+" "+typ)
+		mockParams = append(mockParams, name+" "+mockTyp)
+		args = append(args, arg)
+		m.RecordArgs = append(m.RecordArgs, name)
+	}
+
+	var results, mockResults, namedResults []string
+	for i, r := range flatten(decl.Type.Results) {
+		typ, mockTyp := t.typeString(r.typ, false), t.typeString(r.typ, true)
+		results = append(results, typ)
+		mockResults = append(mockResults, mockTyp)
+		namedResults = append(namedResults, fmt.Sprintf("r%d %v", i, mockTyp))
+	}
+
+	m.Params = strings.Join(params, ", ")
+	m.MockParams = strings.Join(mockParams, ", ")
+	m.Args = strings.Join(args, ", ")
+	m.Results = resultList(results)
+	m.MockResults = resultList(mockResults)
+	m.NamedResults = "(" + strings.Join(namedResults, ", ") + ")"
+	return m
+}
+
+type field struct {
+	name string
+	typ  ast.Expr
+}
+
+// flatten returns the fields of fl, one per name.
+func flatten(fl *ast.FieldList) []field {
+	if fl == nil {
+		return nil
+	}
+	var fields []field
+	for _, f := range fl.List {
+		if len(f.Names) == 0 {
+			fields = append(fields, field{typ: f.Type})
+			continue
+		}
+		for _, name := range f.Names {
+			fields = append(fields, field{name: name.Name, typ: f.Type})
+		}
+	}
+	return fields
+}
+
+func resultList(results []string) string {
+	switch len(results) {
+	case 0:
+		return ""
+	case 1:
+		return results[0]
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// typeString returns the source of the type expr. If qualify is true, the
+// exported identifiers of the package are qualified with its name.
+func (t *templateData) typeString(expr ast.Expr, qualify bool) string {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		if qualify && x.IsExported() {
+			return t.Package + "." + x.Name
+		}
+		return x.Name
+	case *ast.StarExpr:
+		return "*" + t.typeString(x.X, qualify)
+	case *ast.ArrayType:
+		return "[]" + t.typeString(x.Elt, qualify)
+	case *ast.MapType:
+		return fmt.Sprintf("map[%v]%v", t.typeString(x.Key, qualify), t.typeString(x.Value, qualify))
+	case *ast.Ellipsis:
+		return "..." + t.typeString(x.Elt, qualify)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.SelectorExpr:
+		pkg := x.X.(*ast.Ident).Name
+		path, ok := importPaths[pkg]
+		if !ok {
+			log.Fatalf("typeString: unknown package %q", pkg)
+		}
+		t.Imports[pkg] = path
+		t.MockImports[pkg] = path
+		return pkg + "." + x.Sel.Name
+	case *ast.FuncType:
+		var params, results []string
+		for _, p := range flatten(x.Params) {
+			params = append(params, t.typeString(p.typ, qualify))
+		}
+		for _, r := range flatten(x.Results) {
+			results = append(results, t.typeString(r.typ, qualify))
+		}
+		return strings.TrimSpace(fmt.Sprintf("func(%v) %v", strings.Join(params, ", "), resultList(results)))
+	}
+	log.Fatalf("typeString: unknown type %T", expr)
+	return ""
+}
+
+type templateData struct {
+	Package     string
+	ImportPath  string
+	Imports     map[string]string
+	MockImports map[string]string
+	Services    []*service
+
+	services        map[string]*service
+	declaredService map[string]bool
+}
+
+type service struct {
+	Name      string // Name of the service, e.g. UserService.
+	Interface string // Name of the interface, e.g. UserAPI.
+	Methods   []*method
+}
+
+type method struct {
+	Name         string
+	Doc          []string // Lines of the doc comment.
+	Params       string   // Parameters in the package.
+	MockParams   string   // Parameters in the mock package.
+	Args         string   // Arguments forwarding the parameters.
+	RecordArgs   []string // Parameter names.
+	Results      string   // Results in the package.
+	MockResults  string   // Results in the mock package.
+	NamedResults string   // Named results in the mock package.
+}
+
+const interfacesSource = `// Code generated by gen_mocks; DO NOT EDIT.
+
+package {{.Package}}
+{{with .Imports}}
+import (
+  {{- range . -}}
+  "{{.}}"
+  {{end -}}
+)
+{{end}}
+{{range .Services}}
+// {{.Interface}} is the interface implemented by {{.Name}}.
+// It is mocked by {{$.Package}}mock.{{.Interface}}.
+type {{.Interface}} interface {
+{{- range .Methods}}
+{{range .Doc}}{{.}}
+{{end -}}
+{{.Name}}({{.Params}}) {{.Results}}
+{{- end}}
+}
+
+var _ {{.Interface}} = (*{{.Name}})(nil)
+{{end}}
+`
+
+const mocksSource = `// Code generated by gen_mocks; DO NOT EDIT.
+
+package {{.Package}}mock
+
+import (
+  {{- range .MockImports -}}
+  "{{.}}"
+  {{end}}
+  {{.Package}} "{{.ImportPath}}"
+)
+
+{{- $pkg := .Package}}
+{{range .Services}}
+{{- $service := .}}
+// {{.Interface}} is a mock of {{$pkg}}.{{.Interface}}. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type {{.Interface}} struct {
+  Mock
+{{range .Methods}}
+  {{.Name}}Func func({{.MockParams}}) {{.MockResults}}
+{{- end}}
+}
+
+var _ {{$pkg}}.{{.Interface}} = (*{{.Interface}})(nil)
+{{range .Methods}}
+// {{.Name}} records the call and calls {{.Name}}Func.
+func (m *{{$service.Interface}}) {{.Name}}({{.MockParams}}) {{.NamedResults}} {
+  m.record("{{.Name}}"{{range .RecordArgs}}, {{.}}{{end}})
+  if m.{{.Name}}Func != nil {
+    return m.{{.Name}}Func({{.Args}})
+  }
+  return
+}
+{{end}}
+{{end}}
+`
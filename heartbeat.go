@@ -19,7 +19,7 @@ func (s *HearthbeatService) Ping(ctx context.Context) (bool, *http.Response, err
 	u := "heartbeats"
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
-	resp, err := s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(WithOperation(ctx, "HearthbeatService.Ping"), req, nil)
 	if err != nil {
 		return false, resp, errors.WithStack(err)
 	}
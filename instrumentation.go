@@ -0,0 +1,97 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Keys of the attributes of the spans and measurements of API calls.
+const (
+	AttributeOperation  = "treezor.operation"
+	AttributeMethod     = "http.method"
+	AttributeStatusCode = "http.status_code"
+	AttributeErrorCode  = "treezor.error_code"
+	AttributeRetryCount = "treezor.retry_count"
+)
+
+// Attribute is a key-value pair describing a span or a measurement. Value is
+// a string, an int or a bool.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer starts the spans of the API calls made by a Client.
+type Tracer interface {
+	// Start starts a span and returns a context carrying it.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	// RecordError records err and marks the span as failed.
+	RecordError(err error)
+	End()
+}
+
+// Meter records the metrics of the API calls made by a Client.
+type Meter interface {
+	// RecordLatency records the duration of an API call, retries included.
+	RecordLatency(ctx context.Context, latency time.Duration, attrs ...Attribute)
+	// AddError counts a failed API call.
+	AddError(ctx context.Context, attrs ...Attribute)
+}
+
+// instrument wraps next to trace and measure the calls with the Tracer and
+// Meter of the Client.
+func (c *Client) instrument(next Handler) Handler {
+	return func(ctx context.Context, op *Operation, v interface{}) (*http.Response, error) {
+		name := op.Name
+		if name == "" {
+			name = "treezor " + op.Request.Method
+		}
+		var span Span
+		if c.Tracer != nil {
+			ctx, span = c.Tracer.Start(ctx, name)
+		}
+
+		start := time.Now()
+		resp, err := next(ctx, op, v)
+		latency := time.Since(start)
+
+		attrs := []Attribute{
+			{AttributeOperation, op.Name},
+			{AttributeMethod, op.Request.Method},
+		}
+		if resp != nil {
+			attrs = append(attrs, Attribute{AttributeStatusCode, resp.StatusCode})
+		}
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) && len(errResp.Errors) > 0 {
+			attrs = append(attrs, Attribute{AttributeErrorCode, errResp.Errors[0].Code})
+		}
+
+		if span != nil {
+			retries := 0
+			if op.Attempts > 1 {
+				retries = op.Attempts - 1
+			}
+			span.SetAttributes(append(attrs, Attribute{AttributeRetryCount, retries})...)
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.End()
+		}
+		if c.Meter != nil {
+			c.Meter.RecordLatency(ctx, latency, attrs...)
+			if err != nil {
+				c.Meter.AddError(ctx, attrs...)
+			}
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,92 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) { s.err = err }
+func (s *testSpan) End()                  { s.ended = true }
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+func (t *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &testSpan{name: name, attrs: map[string]interface{}{}}
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+type testMeter struct {
+	latencies []time.Duration
+	errors    int
+}
+
+func (m *testMeter) RecordLatency(ctx context.Context, latency time.Duration, attrs ...Attribute) {
+	m.latencies = append(m.latencies, latency)
+}
+
+func (m *testMeter) AddError(ctx context.Context, attrs ...Attribute) {
+	m.errors++
+}
+
+func TestClient_Instrumentation(t *testing.T) {
+	var calls int32
+	client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/payouts/2" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":[{"errorCode":15030,"errorMessage":"insufficient funds"}]}`))
+			return
+		}
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"payouts":[{"payoutId":"1"}]}`))
+	})
+	defer teardown()
+	tracer, meter := &testTracer{}, &testMeter{}
+	client.Tracer, client.Meter = tracer, meter
+
+	_, _, err := client.Payout.Get(context.Background(), "1")
+	assert.Nil(t, err)
+	_, _, err = client.Payout.Get(context.Background(), "2")
+	assert.NotNil(t, err)
+
+	assert.Len(t, tracer.spans, 2)
+	ok, failed := tracer.spans[0], tracer.spans[1]
+	assert.Equal(t, "PayoutService.Get", ok.name)
+	assert.True(t, ok.ended)
+	assert.Nil(t, ok.err)
+	assert.Equal(t, map[string]interface{}{
+		AttributeOperation:  "PayoutService.Get",
+		AttributeMethod:     http.MethodGet,
+		AttributeStatusCode: http.StatusOK,
+		AttributeRetryCount: 1,
+	}, ok.attrs)
+	assert.NotNil(t, failed.err)
+	assert.Equal(t, 15030, failed.attrs[AttributeErrorCode])
+	assert.Equal(t, 0, failed.attrs[AttributeRetryCount])
+
+	assert.Len(t, meter.latencies, 2)
+	assert.Equal(t, 1, meter.errors)
+}
@@ -0,0 +1,99 @@
+package treezor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Logger records the API calls made by a Client.
+type Logger interface {
+	LogCall(ctx context.Context, entry *LogEntry)
+}
+
+// LoggerFunc is an adapter to use an ordinary function as a Logger.
+type LoggerFunc func(ctx context.Context, entry *LogEntry)
+
+// LogCall calls f(ctx, entry).
+func (f LoggerFunc) LogCall(ctx context.Context, entry *LogEntry) {
+	f(ctx, entry)
+}
+
+// LogEntry describes an API call made by a Client.
+type LogEntry struct {
+	Operation  string        // Logical operation, e.g. "PayoutService.Create".
+	Method     string        // HTTP method.
+	URL        string        // URL, with the client_secret parameter redacted.
+	StatusCode int           // HTTP status of the response, 0 if none was received.
+	Latency    time.Duration // Duration of the call, retries included.
+	ErrorCodes []int         // Treezor error codes of the response, if any.
+	Err        error         // Error returned by the call, if any.
+
+	// RequestBody and ResponseBody are the request and decoded response
+	// bodies, as returned by Redact. They are only set if the LogBodies
+	// field of the Client is true.
+	RequestBody  interface{}
+	ResponseBody interface{}
+}
+
+// String returns the entry as a line of key=value pairs.
+func (e *LogEntry) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "operation=%q method=%s url=%q status=%d latency=%v",
+		e.Operation, e.Method, e.URL, e.StatusCode, e.Latency)
+	if len(e.ErrorCodes) > 0 {
+		fmt.Fprintf(&b, " error_codes=%v", e.ErrorCodes)
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, " error=%q", e.Err)
+	}
+	if e.RequestBody != nil {
+		data, _ := json.Marshal(e.RequestBody)
+		fmt.Fprintf(&b, " request=%s", data)
+	}
+	if e.ResponseBody != nil {
+		data, _ := json.Marshal(e.ResponseBody)
+		fmt.Fprintf(&b, " response=%s", data)
+	}
+	return b.String()
+}
+
+// logCalls wraps next to log the calls with the Logger of the Client.
+func (c *Client) logCalls(next Handler) Handler {
+	return func(ctx context.Context, op *Operation, v interface{}) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next(ctx, op, v)
+
+		u := *op.Request.URL
+		entry := &LogEntry{
+			Operation: op.Name,
+			Method:    op.Request.Method,
+			URL:       sanitizeURL(&u).String(),
+			Latency:   time.Since(start),
+			Err:       err,
+		}
+		if resp != nil {
+			entry.StatusCode = resp.StatusCode
+		}
+		var errResp *ErrorResponse
+		if errors.As(err, &errResp) {
+			for _, e := range errResp.Errors {
+				entry.ErrorCodes = append(entry.ErrorCodes, e.Code)
+			}
+		}
+		if c.LogBodies {
+			entry.RequestBody = Redact(op.Body)
+			if _, ok := v.(io.Writer); !ok && err == nil {
+				entry.ResponseBody = Redact(v)
+			}
+		}
+		c.Logger.LogCall(ctx, entry)
+		return resp, err
+	}
+}
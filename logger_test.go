@@ -0,0 +1,88 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Logger(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/cards/1/ChangePIN/" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":[{"errorCode":32056,"errorMessage":"wrong PIN"}]}`))
+			return
+		}
+		w.Write([]byte(`{"users":[{"userId":"1","firstname":"Jane","birthday":"1990-01-02","city":"Paris"}]}`))
+	})
+	defer teardown()
+
+	var entries []*LogEntry
+	client.Logger = LoggerFunc(func(ctx context.Context, entry *LogEntry) {
+		entries = append(entries, entry)
+	})
+	ctx := context.Background()
+
+	t.Run("Without bodies", func(t *testing.T) {
+		entries = nil
+		_, _, err := client.User.Get(ctx, "1")
+		assert.Nil(t, err)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "UserService.Get", entries[0].Operation)
+		assert.Equal(t, http.MethodGet, entries[0].Method)
+		assert.Equal(t, client.BaseURL.String()+"users/1", entries[0].URL)
+		assert.Equal(t, http.StatusOK, entries[0].StatusCode)
+		assert.Nil(t, entries[0].RequestBody)
+		assert.Nil(t, entries[0].ResponseBody)
+	})
+
+	client.LogBodies = true
+	t.Run("Redacted response", func(t *testing.T) {
+		entries = nil
+		_, _, err := client.User.Edit(ctx, "1", &User{Birthday: NewDate(time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)), City: String("Paris")})
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{"birthday": Redacted, "city": "Paris"}, entries[0].RequestBody)
+		assert.Equal(t, map[string]interface{}{
+			"users": []interface{}{map[string]interface{}{"userId": "1", "firstname": Redacted, "birthday": Redacted, "city": "Paris"}},
+		}, entries[0].ResponseBody)
+		assert.NotContains(t, entries[0].String(), "Jane")
+	})
+	t.Run("Error codes", func(t *testing.T) {
+		entries = nil
+		_, _, err := client.Card.ChangePIN(ctx, "1", &PIN{Current: "1234", New: "0000", Confirmation: "0000"})
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, entries[0].StatusCode)
+		assert.Equal(t, []int{32056}, entries[0].ErrorCodes)
+		assert.Equal(t, map[string]interface{}{"currentPIN": Redacted, "newPIN": Redacted, "confirmPIN": Redacted}, entries[0].RequestBody)
+		assert.Nil(t, entries[0].ResponseBody)
+	})
+}
+
+func TestRedact(t *testing.T) {
+	card := &Card{CardID: String("1"), CVV: String("123"), PIN: String("1234"), MaskedPan: String("497010XXXXXX1234")}
+	assert.Equal(t, map[string]interface{}{
+		"cardId":    "1",
+		"CVV":       Redacted,
+		"pin":       Redacted,
+		"maskedPan": "497010XXXXXX1234",
+	}, Redact(card))
+
+	b := &BeneficiaryRequest{
+		Access: Access{UserIP: String("127.0.0.1")},
+		Name:   String("Jane Doe"),
+		IBAN:   String("FR7630001007941234567890185"),
+		BIC:    String("BNPAFRPP"),
+	}
+	assert.Equal(t, map[string]interface{}{
+		"accessUserIp": "127.0.0.1",
+		"name":         Redacted,
+		"iban":         "****0185",
+		"bic":          "BNPAFRPP",
+	}, Redact(b))
+
+	assert.Nil(t, Redact(nil))
+	assert.Nil(t, Redact((*Card)(nil)))
+}
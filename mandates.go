@@ -0,0 +1,217 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// MandateService handles communication with the SEPA Direct Debit mandate
+// related methods of the Treezor API.
+//
+// A mandate is created PENDING, signed by the debtor with a one time password
+// sent by RequestSignatureOTP, and can then be used by SDD pay-ins until it is
+// canceled.
+//
+// Treezor API docs: https://www.treezor.com/api-documentation/#/mandate
+type MandateService service
+
+// Treezor mandate status
+const (
+	MandateStatusPending   = "PENDING"
+	MandateStatusValidated = "VALIDATED"
+	MandateStatusCanceled  = "CANCELED"
+)
+
+// MandateResponse represents a list of SEPA Direct Debit mandates.
+// It may contain only one item.
+type MandateResponse struct {
+	Mandates []*Mandate `json:"mandates"`
+}
+
+// Mandate represents a SEPA Direct Debit mandate.
+type Mandate struct {
+	Access
+	MandateID               *string         `json:"mandateId,omitempty"`
+	Title                   *string         `json:"title,omitempty"`
+	LegalInformations       *string         `json:"legalInformations,omitempty"`
+	UniqueMandateReference  *string         `json:"uniqueMandateReference,omitempty"`
+	MandateStatus           *string         `json:"mandateStatus,omitempty"`
+	UserID                  *string         `json:"userId,omitempty"`
+	DebtorName              *string         `json:"debtorName,omitempty" treezor:"secret"`
+	DebtorAddress           *string         `json:"debtorAddress,omitempty" treezor:"secret"`
+	DebtorCity              *string         `json:"debtorCity,omitempty"`
+	DebtorZipCode           *string         `json:"debtorZipCode,omitempty"`
+	DebtorCountry           *string         `json:"debtorCountry,omitempty"`
+	DebtorIBAN              *string         `json:"debtorIban,omitempty" treezor:"secret,last4"`
+	DebtorBIC               *string         `json:"debtorBic,omitempty"`
+	SequenceType            *string         `json:"sequenceType,omitempty"`
+	CreditorName            *string         `json:"creditorName,omitempty"`
+	SEPACreditorIdentifier  *string         `json:"sepaCreditorIdentifier,omitempty"`
+	CreditorAddress         *string         `json:"creditorAddress,omitempty"`
+	CreditorCity            *string         `json:"creditorCity,omitempty"`
+	CreditorZipCode         *string         `json:"creditorZipCode,omitempty"`
+	CreditorCountry         *string         `json:"creditorCountry,omitempty"`
+	SignatureDate           *Date           `json:"signatureDate,omitempty"`
+	DebtorSignatureIP       *string         `json:"debtorSignatureIp,omitempty"`
+	Signed                  *int64          `json:"signed,omitempty"`
+	RevocationSignatureDate *Date           `json:"revocationSignatureDate,omitempty"`
+	CreatedIP               *string         `json:"createdIp,omitempty"`
+	CreatedDate             *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate            *TimestampParis `json:"modifiedDate,omitempty"`
+	UserIDUltimateCreditor  *string         `json:"userIdUltimateCreditor,omitempty"`
+	TotalRows               *int64          `json:"totalRows,string,omitempty"`
+}
+
+// Create creates a Treezor mandate.
+// The required fields are UserID, SequenceType, DebtorName, DebtorAddress,
+// DebtorCity, DebtorZipCode, DebtorCountry, DebtorIBAN and CreatedIP.
+func (s *MandateService) Create(ctx context.Context, mandate *Mandate) (*Mandate, *http.Response, error) {
+	req, _ := s.client.NewRequest(http.MethodPost, "mandates", mandate)
+
+	b := new(MandateResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MandateService.Create"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.Mandates) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one mandate: %d mandates returned", len(b.Mandates))
+	}
+	return b.Mandates[0], resp, nil
+}
+
+// Get returns a mandate.
+func (s *MandateService) Get(ctx context.Context, mandateID string) (*Mandate, *http.Response, error) {
+	u := fmt.Sprintf("mandates/%s", mandateID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(MandateResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MandateService.Get"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.Mandates) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one mandate: %d mandates returned", len(b.Mandates))
+	}
+	return b.Mandates[0], resp, nil
+}
+
+// MandateListOptions specifies the optional parameters to the MandateService.List.
+type MandateListOptions struct {
+	MandateStatus          string `url:"mandateStatus,omitempty"`
+	UserID                 string `url:"userId,omitempty"`
+	UniqueMandateReference string `url:"uniqueMandateReference,omitempty"`
+	CreatedDateFrom        string `url:"createdDateFrom,omitempty"`
+	CreatedDateTo          string `url:"createdDateTo,omitempty"`
+
+	ListOptions
+}
+
+// List the mandates for the authenticated user.
+func (s *MandateService) List(ctx context.Context, opt *MandateListOptions) (*MandateResponse, *http.Response, error) {
+	u := "mandates"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(MandateResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MandateService.List"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	return b, resp, nil
+}
+
+// RequestSignatureOTP sends the debtor of a PENDING mandate the one time
+// password needed by Sign.
+func (s *MandateService) RequestSignatureOTP(ctx context.Context, mandateID string) (*Mandate, *http.Response, error) {
+	u := fmt.Sprintf("mandates/%s/otp/", mandateID)
+	req, _ := s.client.NewRequest(http.MethodPost, u, nil)
+
+	b := new(MandateResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MandateService.RequestSignatureOTP"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.Mandates) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one mandate: %d mandates returned", len(b.Mandates))
+	}
+	return b.Mandates[0], resp, nil
+}
+
+// MandateSignature represents the electronic signature of a mandate by its debtor.
+type MandateSignature struct {
+	// OTP is the one time password sent to the debtor by RequestSignatureOTP.
+	OTP *string `json:"otp,omitempty" treezor:"secret"`
+	// DebtorSignatureIP is the IP address of the debtor when signing.
+	DebtorSignatureIP *string `json:"debtorSignatureIp,omitempty"`
+}
+
+// Sign signs a PENDING mandate with the one time password received by its
+// debtor. The mandate is VALIDATED once signed.
+func (s *MandateService) Sign(ctx context.Context, mandateID string, signature *MandateSignature) (*Mandate, *http.Response, error) {
+	if signature.GetOTP() == "" {
+		return nil, nil, errors.New("mandate signature requires an OTP")
+	}
+
+	u := fmt.Sprintf("mandates/%s/sign/", mandateID)
+	req, _ := s.client.NewRequest(http.MethodPut, u, signature)
+
+	b := new(MandateResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MandateService.Sign"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.Mandates) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one mandate: %d mandates returned", len(b.Mandates))
+	}
+	return b.Mandates[0], resp, nil
+}
+
+// GetPDF writes the PDF document of a mandate to w.
+func (s *MandateService) GetPDF(ctx context.Context, mandateID string, w io.Writer) (*http.Response, error) {
+	u := fmt.Sprintf("mandates/%s/pdf/", mandateID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+	req.Header.Set("Accept", "application/pdf")
+
+	resp, err := s.client.Do(WithOperation(ctx, "MandateService.GetPDF"), req, w)
+	return resp, errors.WithStack(err)
+}
+
+// MandateCancelOptions specifies the parameters to the MandateService.Cancel.
+type MandateCancelOptions struct {
+	// Origin is the party revoking the mandate: CREDITOR or DEBTOR.
+	Origin string `url:"origin,omitempty"`
+}
+
+// Cancel cancels a mandate. Its status is changed to CANCELED and it can no
+// longer be used by SDD pay-ins.
+func (s *MandateService) Cancel(ctx context.Context, mandateID string, opt *MandateCancelOptions) (*Mandate, *http.Response, error) {
+	u := fmt.Sprintf("mandates/%s", mandateID)
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
+
+	b := new(MandateResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MandateService.Cancel"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.Mandates) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one mandate: %d mandates returned", len(b.Mandates))
+	}
+	return b.Mandates[0], resp, nil
+}
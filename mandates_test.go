@@ -0,0 +1,80 @@
+package treezor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMandateService_RequestSignatureOTP(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mandates/1/otp/", r.URL.Path)
+		w.Write([]byte(`{"mandates":[{"mandateId":"1","mandateStatus":"PENDING"}]}`))
+	})
+	defer teardown()
+
+	m, _, err := client.Mandate.RequestSignatureOTP(context.Background(), "1")
+	require.NoError(t, err)
+	assert.Equal(t, "1", m.GetMandateID())
+	assert.Equal(t, MandateStatusPending, m.GetMandateStatus())
+}
+
+func TestMandateService_Sign(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var body map[string]interface{}
+		client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/mandates/1/sign/", r.URL.Path)
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+			w.Write([]byte(`{"mandates":[{"mandateId":"1","mandateStatus":"VALIDATED","signed":1}]}`))
+		})
+		defer teardown()
+
+		m, _, err := client.Mandate.Sign(context.Background(), "1", &MandateSignature{
+			OTP:               String("123456"),
+			DebtorSignatureIP: String("192.0.2.1"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, MandateStatusValidated, m.GetMandateStatus())
+		assert.Equal(t, int64(1), m.GetSigned())
+		assert.Equal(t, map[string]interface{}{"otp": "123456", "debtorSignatureIp": "192.0.2.1"}, body)
+	})
+	t.Run("Error empty OTP", func(t *testing.T) {
+		calls := 0
+		client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+		})
+		defer teardown()
+
+		for _, signature := range []*MandateSignature{nil, {}, {OTP: String("")}} {
+			m, resp, err := client.Mandate.Sign(context.Background(), "1", signature)
+			assert.Error(t, err)
+			assert.Nil(t, m)
+			assert.Nil(t, resp)
+		}
+		assert.Equal(t, 0, calls, "no request is sent without an OTP")
+	})
+}
+
+func TestMandateService_GetPDF(t *testing.T) {
+	pdf := []byte("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n1 0 obj\n")
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mandates/1/pdf/", r.URL.Path)
+		assert.Equal(t, "application/pdf", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write(pdf)
+	})
+	defer teardown()
+
+	var buf bytes.Buffer
+	_, err := client.Mandate.GetPDF(context.Background(), "1", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, pdf, buf.Bytes())
+}
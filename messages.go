@@ -56,6 +56,19 @@ func messageMAC(signature string) ([]byte, func() hash.Hash, error) {
 //     }
 //
 func ValidatePayload(r *http.Request, secretKey []byte) (evt *Event, err error) {
+	evt, err = decodeEvent(r)
+	if err != nil {
+		return evt, err
+	}
+	if err := validateSignature(*evt.PayloadSignature, *evt.RawPayload, secretKey); err != nil {
+		return evt, errors.WithStack(err)
+	}
+	return evt, nil
+}
+
+// decodeEvent decodes the event of an incoming Treezor Webhook request and
+// checks that it carries a payload and a signature.
+func decodeEvent(r *http.Request) (evt *Event, err error) {
 	evt = new(Event)
 
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
@@ -83,9 +96,6 @@ func ValidatePayload(r *http.Request, secretKey []byte) (evt *Event, err error)
 	if evt.PayloadSignature == nil {
 		return evt, errors.New("Webhook request has missing signature")
 	}
-	if err := validateSignature(*evt.PayloadSignature, *evt.RawPayload, secretKey); err != nil {
-		return evt, errors.WithStack(err)
-	}
 	return evt, nil
 }
 
@@ -0,0 +1,97 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+)
+
+// Operation is an API call going through the middleware chain of a Client.
+type Operation struct {
+	// Name is the logical operation, e.g. "PayoutService.Create". It is empty
+	// for requests sent with Do outside of the services, unless set with
+	// WithOperation.
+	Name string
+
+	// Request is the HTTP request to send. Middlewares may modify it, for
+	// example to add headers.
+	Request *http.Request
+
+	// Body is the value the request body was encoded from, e.g. a *Payout, or
+	// nil if the request has no body.
+	Body interface{}
+
+	// Attempts is the number of attempts made to send Request, retries
+	// included. It is set once the call returns.
+	Attempts int
+}
+
+// Handler sends the request of an operation and decodes the API response into
+// v, like Client.Do.
+type Handler func(ctx context.Context, op *Operation, v interface{}) (*http.Response, error)
+
+// Middleware wraps a Handler to add behavior around API calls, such as
+// logging, metrics or header injection. Once next returns, v holds the
+// decoded response, if any.
+//
+// Example usage:
+//
+//	client.Use(func(next treezor.Handler) treezor.Handler {
+//		return func(ctx context.Context, op *treezor.Operation, v interface{}) (*http.Response, error) {
+//			start := time.Now()
+//			resp, err := next(ctx, op, v)
+//			log.Printf("%s took %v: %v", op.Name, time.Since(start), err)
+//			return resp, err
+//		}
+//	})
+type Middleware func(next Handler) Handler
+
+// Use adds middlewares around the API calls made by the Client. The first
+// middleware is the outermost one. Use must not be called concurrently with
+// API calls.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// handler returns the Handler of the Client, wrapped by its middlewares.
+func (c *Client) handler() Handler {
+	h := Handler(c.do)
+	if c.CircuitBreaker != nil {
+		h = c.breakCircuit(h)
+	}
+	if c.Logger != nil {
+		h = c.logCalls(h)
+	}
+	if c.Tracer != nil || c.Meter != nil {
+		h = c.instrument(h)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		h = c.middlewares[i](h)
+	}
+	return h
+}
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx carrying the operation name seen by
+// the middlewares for the requests sent with it.
+func WithOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, name)
+}
+
+// OperationName returns the operation name carried by ctx, if any.
+func OperationName(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string)
+	return name
+}
+
+type requestBodyKey struct{}
+
+// withRequestBody returns a shallow copy of req remembering the value its
+// body was encoded from.
+func withRequestBody(req *http.Request, body interface{}) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), requestBodyKey{}, body))
+}
+
+func requestBody(req *http.Request) interface{} {
+	return req.Context().Value(requestBodyKey{})
+}
@@ -0,0 +1,59 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Use(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Request-Id") != "42" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{"payouts":[{"payoutId":"1"}]}`))
+	})
+	defer teardown()
+
+	var trace []string
+	var body interface{}
+	var decoded interface{}
+	client.Use(
+		func(next Handler) Handler {
+			return func(ctx context.Context, op *Operation, v interface{}) (*http.Response, error) {
+				trace = append(trace, "outer "+op.Name)
+				body = op.Body
+				resp, err := next(ctx, op, v)
+				decoded = v
+				return resp, err
+			}
+		},
+		func(next Handler) Handler {
+			return func(ctx context.Context, op *Operation, v interface{}) (*http.Response, error) {
+				trace = append(trace, "inner "+op.Name)
+				op.Request.Header.Set("X-Request-Id", "42")
+				return next(ctx, op, v)
+			}
+		},
+	)
+
+	payout := &Payout{WalletID: String("1"), Currency: EUR}
+	p, _, err := client.Payout.Create(context.Background(), payout)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", p.GetPayoutID())
+	assert.Equal(t, []string{"outer PayoutService.Create", "inner PayoutService.Create"}, trace)
+	assert.Equal(t, payout, body)
+	assert.Equal(t, &PayoutResponse{Payouts: []*Payout{p}}, decoded)
+
+	t.Run("Without service", func(t *testing.T) {
+		trace, body = nil, nil
+		req, _ := client.NewRequest(http.MethodGet, "payouts/1", nil)
+		_, err := client.Do(context.Background(), req, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"outer ", "inner "}, trace)
+		assert.Nil(t, body)
+	})
+}
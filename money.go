@@ -0,0 +1,225 @@
+package treezor
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCurrencyMismatch is returned when an operation combines two Money values
+// of different currencies.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money is an exact amount of money, stored as an integer number of minor
+// units (e.g. cents) of its currency.
+//
+// Money marshals to and from the string-encoded decimal amounts used by the
+// Treezor API, e.g. "12.34". Since the API sends the currency in a separate
+// field, a Money unmarshalled from JSON only carries a currency if it was set
+// beforehand; otherwise amounts are read with 2 decimals.
+//
+// The number of decimals of an amount is given by Currency.MinorUnits.
+//
+// The float64 amount fields of the API structs, such as Payin.Amount, have
+// Money accessors, such as Payin.AmountMoney, which round them to the minor
+// units of their currency. A float64 holds any amount of up to 15 significant
+// digits closely enough for this rounding to be exact.
+type Money struct {
+	Amount   int64    // Amount in minor units of Currency.
+	Currency Currency // ISO 4217 currency code.
+}
+
+// NewMoney returns the Money worth minor units of currency c.
+func NewMoney(minor int64, c Currency) Money {
+	return Money{Amount: minor, Currency: c}
+}
+
+// ParseMoney parses a decimal amount such as "12.34" or "-0.5" into Money.
+// It returns an error if the amount has more decimals than the currency
+// allows.
+func ParseMoney(s string, c Currency) (Money, error) {
+	minor, err := parseMinorUnits(s, moneyScale(c))
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: minor, Currency: c}, nil
+}
+
+// MoneyFromFloat converts a float amount, as found in the float64 amount
+// fields of the API structs, to Money. The amount is rounded to the nearest
+// minor unit.
+func MoneyFromFloat(f float64, c Currency) Money {
+	return Money{Amount: int64(math.Round(f * math.Pow10(moneyScale(c)))), Currency: c}
+}
+
+// Float64 returns the amount as a float, for use in the float64 amount fields
+// of the API structs.
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.Decimal(), 64)
+	return f
+}
+
+// Decimal returns the amount formatted as a decimal string, e.g. "12.34".
+func (m Money) Decimal() string {
+	scale := moneyScale(m.Currency)
+	neg := m.Amount < 0
+	// Work on the absolute value as an unsigned integer so that the smallest
+	// int64 does not overflow.
+	abs := uint64(m.Amount)
+	if neg {
+		abs = -abs
+	}
+	digits := strconv.FormatUint(abs, 10)
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+func (m Money) String() string {
+	if m.Currency == "" {
+		return m.Decimal()
+	}
+	return m.Decimal() + " " + string(m.Currency)
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool { return m.Amount == 0 }
+
+// IsNegative reports whether the amount is below zero.
+func (m Money) IsNegative() bool { return m.Amount < 0 }
+
+// Neg returns the opposite amount.
+func (m Money) Neg() Money { return Money{Amount: -m.Amount, Currency: m.Currency} }
+
+// Add returns m + o. It fails if the currencies differ or on overflow.
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.checkCurrency(o); err != nil {
+		return Money{}, err
+	}
+	sum := m.Amount + o.Amount
+	if (o.Amount > 0 && sum < m.Amount) || (o.Amount < 0 && sum > m.Amount) {
+		return Money{}, errors.Errorf("overflow adding %v to %v", o, m)
+	}
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+// Sub returns m - o. It fails if the currencies differ or on overflow.
+func (m Money) Sub(o Money) (Money, error) {
+	if o.Amount == math.MinInt64 {
+		return Money{}, errors.Errorf("overflow subtracting %v from %v", o, m)
+	}
+	return m.Add(o.Neg())
+}
+
+// Cmp compares m and o and returns -1, 0 or +1 when m is respectively lower
+// than, equal to or greater than o. It fails if the currencies differ.
+func (m Money) Cmp(o Money) (int, error) {
+	if err := m.checkCurrency(o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func (m Money) checkCurrency(o Money) error {
+	if m.Currency != o.Currency {
+		return errors.Wrapf(ErrCurrencyMismatch, "%v and %v", m.Currency, o.Currency)
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The amount is returned as a decimal string, e.g. "12.34".
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + m.Decimal() + `"`), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The amount is expected as a decimal string or number. The currency of m,
+// if already set, is kept and determines the number of decimals.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	minor, err := parseMinorUnits(s, moneyScale(m.Currency))
+	if err != nil {
+		return err
+	}
+	m.Amount = minor
+	return nil
+}
+
+// parseMinorUnits parses the decimal s into an integer number of units of
+// 10^-scale, without going through floating point.
+func parseMinorUnits(s string, scale int) (int64, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg, s = true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return 0, errors.Errorf("invalid amount %q", orig)
+	}
+	// Extra decimals are only accepted if they are zeros.
+	if len(fracPart) > scale {
+		if strings.Trim(fracPart[scale:], "0") != "" {
+			return 0, errors.Errorf("amount %q has more than %d decimals", orig, scale)
+		}
+		fracPart = fracPart[:scale]
+	}
+	fracPart += strings.Repeat("0", scale-len(fracPart))
+
+	digits := intPart + fracPart
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, errors.Errorf("invalid amount %q", orig)
+		}
+	}
+	if neg {
+		digits = "-" + digits
+	}
+	minor, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid amount %q: %v", orig, err)
+	}
+	return minor, nil
+}
+
+// moneyScale returns the number of decimals used for amounts in currency c.
+// Amounts of an unknown currency use 2 decimals.
+func moneyScale(c Currency) int {
+	if n := c.MinorUnits(); n >= 0 {
+		return n
+	}
+	return 2
+}
@@ -0,0 +1,123 @@
+package treezor
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		for s, minor := range map[string]int64{
+			"12.34": 1234,
+			"-0.5":  -50,
+			"7":     700,
+			".01":   1,
+			"1.200": 120,
+		} {
+			m, err := ParseMoney(s, EUR)
+			assert.Nil(t, err, s)
+			assert.Equal(t, NewMoney(minor, EUR), m, s)
+		}
+	})
+	t.Run("Error too many decimals", func(t *testing.T) {
+		_, err := ParseMoney("1.234", EUR)
+		assert.NotNil(t, err)
+	})
+	t.Run("Error invalid amount", func(t *testing.T) {
+		for _, s := range []string{"", "-", "1,2", "1.2.3", "abc"} {
+			_, err := ParseMoney(s, EUR)
+			assert.NotNil(t, err, s)
+		}
+	})
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	t.Run("Success exact sum", func(t *testing.T) {
+		sum := NewMoney(0, EUR)
+		for i := 0; i < 10; i++ {
+			sum, _ = sum.Add(MoneyFromFloat(0.1, EUR))
+		}
+		assert.Equal(t, NewMoney(100, EUR), sum)
+		assert.Equal(t, "1.00 EUR", sum.String())
+	})
+	t.Run("Success sub and cmp", func(t *testing.T) {
+		diff, err := NewMoney(100, EUR).Sub(NewMoney(250, EUR))
+		assert.Nil(t, err)
+		assert.Equal(t, "-1.50", diff.Decimal())
+		c, err := diff.Cmp(NewMoney(0, EUR))
+		assert.Nil(t, err)
+		assert.Equal(t, -1, c)
+	})
+	t.Run("Error currency mismatch", func(t *testing.T) {
+		_, err := NewMoney(100, EUR).Add(NewMoney(100, USD))
+		assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+		_, err = NewMoney(100, EUR).Cmp(NewMoney(100, USD))
+		assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+	})
+	t.Run("Error overflow", func(t *testing.T) {
+		_, err := NewMoney(math.MaxInt64, EUR).Add(NewMoney(1, EUR))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestMoney_JSON(t *testing.T) {
+	t.Run("Success marshal", func(t *testing.T) {
+		data, err := json.Marshal(NewMoney(5, EUR))
+		assert.Nil(t, err)
+		assert.Equal(t, `"0.05"`, string(data))
+	})
+	t.Run("Success unmarshal string and number", func(t *testing.T) {
+		var v struct {
+			A Money `json:"a"`
+			B Money `json:"b"`
+		}
+		err := json.Unmarshal([]byte(`{"a":"10.10","b":3.5}`), &v)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1010), v.A.Amount)
+		assert.Equal(t, int64(350), v.B.Amount)
+	})
+}
+
+func TestPayout_AmountMoney(t *testing.T) {
+	p := &Payout{}
+	p.SetAmountMoney(NewMoney(1999, EUR))
+	assert.Equal(t, 19.99, p.GetAmount())
+	assert.Equal(t, EUR, p.Currency)
+	assert.Equal(t, NewMoney(1999, EUR), p.AmountMoney())
+	assert.Equal(t, Money{}, (*Payout)(nil).AmountMoney())
+}
+
+func TestPayin_AmountMoney(t *testing.T) {
+	t.Run("Success decoded amounts", func(t *testing.T) {
+		p := new(Payin)
+		err := json.Unmarshal([]byte(`{"payinId":"1","amount":"9007199254740.93","refundAmount":"0.10","currency":"EUR"}`), p)
+		assert.Nil(t, err)
+		assert.Equal(t, "1", p.GetPayinID())
+		assert.Equal(t, NewMoney(900719925474093, EUR), p.AmountMoney())
+		assert.Equal(t, NewMoney(10, EUR), p.RefundAmountMoney())
+		assert.Equal(t, Money{Currency: EUR}, p.DistributorFeeMoney())
+	})
+	t.Run("Success currency with 3 decimals", func(t *testing.T) {
+		p := new(Payin)
+		err := json.Unmarshal([]byte(`{"amount":"1.234","currency":"KWD"}`), p)
+		assert.Nil(t, err)
+		assert.Equal(t, NewMoney(1234, Currency("KWD")), p.AmountMoney())
+	})
+	t.Run("Success changed float", func(t *testing.T) {
+		p := new(Payin)
+		err := json.Unmarshal([]byte(`{"amount":"12.34","currency":"EUR"}`), p)
+		assert.Nil(t, err)
+		p.Amount = Float64(0.1 + 0.2)
+		assert.Equal(t, NewMoney(30, EUR), p.AmountMoney())
+	})
+	t.Run("Success comparable", func(t *testing.T) {
+		p := &Payin{}
+		p.SetAmountMoney(NewMoney(100, EUR))
+		c := *p
+		assert.True(t, c == *p)
+	})
+}
@@ -0,0 +1,245 @@
+package treezor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	tokenPath           = "/oauth/token"
+	defaultExpiryDelta  = 30 * time.Second
+	defaultTokenTimeout = 30 * time.Second
+)
+
+// Token is an access token returned by the Treezor OAuth2 endpoint.
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+
+	// Expiry is the time at which the token expires, computed from ExpiresIn
+	// when the token is received.
+	Expiry time.Time `json:"-"`
+}
+
+// valid reports whether the token can still be used for at least delta.
+func (t *Token) valid(delta time.Duration) bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	if t.Expiry.IsZero() {
+		return true
+	}
+	return time.Now().Add(delta).Before(t.Expiry)
+}
+
+// ClientCredentialsTransport is an http.RoundTripper that authenticates all
+// requests with a JWT obtained through the OAuth2 client credentials grant.
+// The token is cached and refreshed shortly before it expires; concurrent
+// requests share a single refresh.
+type ClientCredentialsTransport struct {
+	ClientID     string   // Treezor client_id
+	ClientSecret string   // Treezor client_secret
+	Scopes       []string // Optional scopes, e.g. "read_write" or "admin"
+
+	// TokenURL is the OAuth2 token endpoint. If empty, /oauth/token on the
+	// host of the outgoing request is used, or on the host of the public
+	// Treezor API when calling Token.
+	TokenURL string
+
+	// ExpiryDelta is how long before its expiry a token is refreshed.
+	// Defaults to 30s if zero.
+	ExpiryDelta time.Duration
+
+	// TokenTimeout bounds the token requests. They are shared by concurrent
+	// callers, so they do not use the context of any of them.
+	// Defaults to 30s if zero.
+	TokenTimeout time.Duration
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu    sync.Mutex
+	token *Token
+	call  *tokenCall
+}
+
+// tokenCall is an in-flight token request shared by concurrent callers.
+type tokenCall struct {
+	done  chan struct{}
+	token *Token
+	err   error
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *ClientCredentialsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokenFrom(req.Context(), t.tokenURL(req.URL))
+	if err != nil {
+		// RoundTrip must always close the body, including on errors.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+
+	// To set extra headers, we must make a copy of the Request so
+	// that we don't modify the Request we were given. This is required by the
+	// specification of http.RoundTripper.
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Header = make(http.Header, len(req.Header))
+	for k, s := range req.Header {
+		req2.Header[k] = append([]string(nil), s...)
+	}
+	req2.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	resp, err := t.transport().RoundTrip(req2)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		// The token may have been revoked: drop it so that the next request
+		// fetches a new one.
+		t.mu.Lock()
+		if t.token == token {
+			t.token = nil
+		}
+		t.mu.Unlock()
+	}
+	return resp, err
+}
+
+// Token returns a valid access token, fetching a new one from TokenURL if the
+// cached one is missing or about to expire.
+func (t *ClientCredentialsTransport) Token(ctx context.Context) (*Token, error) {
+	u, _ := url.Parse(defaultBaseURL)
+	return t.tokenFrom(ctx, t.tokenURL(u))
+}
+
+// tokenURL returns TokenURL, or /oauth/token on the host of u if it is empty.
+func (t *ClientCredentialsTransport) tokenURL(u *url.URL) string {
+	if t.TokenURL != "" {
+		return t.TokenURL
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: tokenPath}).String()
+}
+
+func (t *ClientCredentialsTransport) tokenFrom(ctx context.Context, tokenURL string) (*Token, error) {
+	t.mu.Lock()
+	if t.token.valid(t.expiryDelta()) {
+		token := t.token
+		t.mu.Unlock()
+		return token, nil
+	}
+	call := t.call
+	if call == nil {
+		call = &tokenCall{done: make(chan struct{})}
+		t.call = call
+		go t.runCall(call, tokenURL)
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// runCall fetches the token of call, detached from the contexts of the
+// callers waiting for it so that one of them giving up does not fail the
+// others.
+func (t *ClientCredentialsTransport) runCall(call *tokenCall, tokenURL string) {
+	ctx, cancel := context.WithTimeout(context.Background(), t.tokenTimeout())
+	defer cancel()
+	call.token, call.err = t.fetchToken(ctx, tokenURL)
+
+	t.mu.Lock()
+	if call.err == nil {
+		t.token = call.token
+	}
+	t.call = nil
+	t.mu.Unlock()
+	close(call.done)
+}
+
+// fetchToken exchanges the client credentials for a new token.
+func (t *ClientCredentialsTransport) fetchToken(ctx context.Context, tokenURL string) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", t.ClientID)
+	form.Set("client_secret", t.ClientSecret)
+	if len(t.Scopes) > 0 {
+		form.Set("scope", strings.Join(t.Scopes, " "))
+	}
+
+	req, err := http.NewRequest(http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil {
+		// If the error type is *url.Error, sanitize its URL before returning.
+		if e, ok := err.(*url.Error); ok {
+			if u, perr := url.Parse(e.URL); perr == nil {
+				e.URL = sanitizeURL(u).String()
+				return nil, e
+			}
+		}
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	if err := CheckResponse(resp); err != nil {
+		return nil, err
+	}
+
+	token := new(Token)
+	if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("API did not return an access token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// using the OAuth2 client credentials grant.
+func (t *ClientCredentialsTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *ClientCredentialsTransport) expiryDelta() time.Duration {
+	if t.ExpiryDelta <= 0 {
+		return defaultExpiryDelta
+	}
+	return t.ExpiryDelta
+}
+
+func (t *ClientCredentialsTransport) tokenTimeout() time.Duration {
+	if t.TokenTimeout <= 0 {
+		return defaultTokenTimeout
+	}
+	return t.TokenTimeout
+}
+
+func (t *ClientCredentialsTransport) transport() http.RoundTripper {
+	if t.Transport == nil {
+		return http.DefaultTransport
+	}
+	return t.Transport
+}
@@ -0,0 +1,153 @@
+package treezor
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientCredentialsTransport_RoundTrip(t *testing.T) {
+	t.Run("Success concurrent requests share one token", func(t *testing.T) {
+		var tokenCalls int32
+		mux := http.NewServeMux()
+		mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&tokenCalls, 1)
+			assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+			assert.Equal(t, "id", r.FormValue("client_id"))
+			assert.Equal(t, "secret", r.FormValue("client_secret"))
+			assert.Equal(t, "read_only read_write", r.FormValue("scope"))
+			time.Sleep(10 * time.Millisecond)
+			w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"jwt"}`))
+		})
+		mux.HandleFunc("/v1/index.php/heartbeats", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer jwt", r.Header.Get("Authorization"))
+		})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		tr := &ClientCredentialsTransport{ClientID: "id", ClientSecret: "secret", Scopes: []string{"read_only", "read_write"}}
+		client := NewClient(tr.Client(), false)
+		client.BaseURL.Scheme, client.BaseURL.Host = "http", server.Listener.Addr().String()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ok, _, err := client.Hearthbeat.Ping(context.Background())
+				assert.Nil(t, err)
+				assert.True(t, ok)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), atomic.LoadInt32(&tokenCalls))
+	})
+	t.Run("Success refresh before expiry", func(t *testing.T) {
+		var tokenCalls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&tokenCalls, 1)
+			w.Write([]byte(`{"token_type":"Bearer","expires_in":10,"access_token":"jwt"}`))
+		}))
+		defer server.Close()
+
+		tr := &ClientCredentialsTransport{ClientID: "id", ClientSecret: "secret", TokenURL: server.URL, ExpiryDelta: time.Minute}
+		_, err := tr.Token(context.Background())
+		assert.Nil(t, err)
+		_, err = tr.Token(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&tokenCalls))
+	})
+	t.Run("Success leader canceled", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			w.Write([]byte(`{"token_type":"Bearer","expires_in":3600,"access_token":"jwt"}`))
+		}))
+		defer server.Close()
+
+		tr := &ClientCredentialsTransport{ClientID: "id", ClientSecret: "secret", TokenURL: server.URL}
+		ctx, cancel := context.WithCancel(context.Background())
+		leader := make(chan error)
+		go func() {
+			_, err := tr.Token(ctx)
+			leader <- err
+		}()
+		follower := make(chan *Token)
+		go func() {
+			token, err := tr.Token(context.Background())
+			assert.Nil(t, err)
+			follower <- token
+		}()
+
+		cancel()
+		assert.Equal(t, context.Canceled, <-leader)
+		close(release)
+		assert.Equal(t, "jwt", (<-follower).AccessToken)
+	})
+	t.Run("Success default token URL", func(t *testing.T) {
+		var tokenURL string
+		tr := &ClientCredentialsTransport{ClientID: "id", ClientSecret: "secret", Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			tokenURL = req.URL.String()
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"token_type":"Bearer","expires_in":3600,"access_token":"jwt"}`)),
+			}, nil
+		})}
+		token, err := tr.Token(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "jwt", token.AccessToken)
+		assert.Equal(t, "https://treezor.com/oauth/token", tokenURL)
+	})
+	t.Run("Error token request closes the body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		tr := &ClientCredentialsTransport{ClientID: "id", ClientSecret: "secret", TokenURL: server.URL}
+		body := &closeRecorder{Reader: strings.NewReader(`{}`)}
+		req, _ := http.NewRequest(http.MethodPost, server.URL+"/users", body)
+		_, err := tr.RoundTrip(req)
+		assert.NotNil(t, err)
+		assert.True(t, body.closed)
+	})
+	t.Run("Error invalid credentials", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		tr := &ClientCredentialsTransport{ClientID: "id", ClientSecret: "secret", TokenURL: server.URL}
+		token, err := tr.Token(context.Background())
+		assert.Nil(t, token)
+		assert.IsType(t, &ErrorResponse{}, err)
+		assert.NotContains(t, err.Error(), "secret")
+	})
+}
+
+// roundTripFunc is an http.RoundTripper calling itself.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// closeRecorder is a request body recording whether it was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
@@ -0,0 +1,22 @@
+package treezor
+
+// OneClickCardResponse represents a list of one-click cards.
+// It may contain only one item.
+type OneClickCardResponse struct {
+	OneClickCards []*OneClickCard `json:"oneclickcards"`
+}
+
+// OneClickCard represents a card registered by a user to make card pay-ins
+// without entering its details again.
+type OneClickCard struct {
+	Access
+	OneClickCardID     *string         `json:"oneclickcardId,omitempty"`
+	OneClickCardTag    *string         `json:"oneclickcardTag,omitempty"`
+	OneClickCardStatus *string         `json:"oneclickcardStatus,omitempty"`
+	UserID             *string         `json:"userId,omitempty"`
+	MaskedPan          *string         `json:"maskedPan,omitempty"`
+	ExpiryDate         *string         `json:"expiryDate,omitempty"`
+	CardBrand          *string         `json:"cardBrand,omitempty"`
+	CreatedDate        *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate       *TimestampParis `json:"modifiedDate,omitempty"`
+}
@@ -0,0 +1,112 @@
+package treezor
+
+import (
+	"context"
+	"strconv"
+)
+
+// pageIterator walks the pages of a List endpoint. The typed iterators of each
+// service, generated by gen_iterators, embed it and provide a fetch function
+// which loads one page into their own buffer.
+//
+// The iteration stops when a page is empty, when the TotalRows reported by the
+// API have been seen, when a page is shorter than opt.PerPage, or when a page
+// starts with the same item as the previous one, in case the API ignores the
+// page number.
+type pageIterator struct {
+	ctx  context.Context
+	opt  *ListOptions
+	done bool
+	err  error
+
+	// seen counts the items fetched so far and taken the items handed out to
+	// the caller.
+	seen  int64
+	taken int
+	total int64
+
+	// firstID is the ID of the first item of the last page.
+	firstID string
+
+	// fetch loads the page described by opt and describes it.
+	fetch func(ctx context.Context) (page, error)
+}
+
+// page describes a page loaded by the fetch function of a pageIterator.
+type page struct {
+	n         int    // Number of items in the page.
+	totalRows int64  // TotalRows reported by the API, if any.
+	firstID   string // ID of the first item, if any.
+}
+
+func newPageIterator(ctx context.Context, opt *ListOptions, fetch func(ctx context.Context) (page, error)) pageIterator {
+	if opt.Page <= 0 {
+		opt.Page = 1
+	}
+	return pageIterator{ctx: ctx, opt: opt, fetch: fetch}
+}
+
+// nextPage fetches the next page. It returns false once the iteration is over,
+// either because the results are exhausted or because an error occurred; the
+// fetched page must then be discarded.
+func (p *pageIterator) nextPage() bool {
+	if p.done {
+		return false
+	}
+	if p.opt.MaxItems > 0 && p.taken >= p.opt.MaxItems {
+		p.done = true
+		return false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err, p.done = err, true
+		return false
+	}
+
+	pg, err := p.fetch(p.ctx)
+	if err != nil {
+		p.err, p.done = err, true
+		return false
+	}
+	p.opt.Page++
+	if pg.n == 0 || (pg.firstID != "" && pg.firstID == p.firstID) {
+		p.done = true
+		return false
+	}
+	p.firstID = pg.firstID
+	p.seen += int64(pg.n)
+	if pg.totalRows > 0 {
+		p.total = pg.totalRows
+	}
+
+	switch {
+	case p.total > 0 && p.seen >= p.total:
+		p.done = true
+	case p.opt.PerPage > 0 && pg.n < p.opt.PerPage:
+		p.done = true
+	}
+	return true
+}
+
+// take records that an item is handed out to the caller. It returns false if
+// MaxItems has been reached.
+func (p *pageIterator) take() bool {
+	if p.opt.MaxItems > 0 && p.taken >= p.opt.MaxItems {
+		p.done = true
+		return false
+	}
+	p.taken++
+	return true
+}
+
+// int64ID returns id as the ID of an item, or "" if it is zero.
+func int64ID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *pageIterator) Err() error {
+	return p.err
+}
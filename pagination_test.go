@@ -0,0 +1,119 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newPaginationTestClient serves total users over pages of perPage items.
+func newPaginationTestClient(total, perPage int) (*Client, func()) {
+	return newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("pageNumber"))
+		var users []string
+		for i := (page - 1) * perPage; i < page*perPage && i < total; i++ {
+			users = append(users, fmt.Sprintf(`{"userId":"%d","totalRows":"%d"}`, i, total))
+		}
+		fmt.Fprintf(w, `{"users":[%s]}`, strings.Join(users, ","))
+	})
+}
+
+func TestUserService_ListAll(t *testing.T) {
+	t.Run("Success all pages", func(t *testing.T) {
+		client, teardown := newPaginationTestClient(25, 10)
+		defer teardown()
+
+		users, err := client.User.ListAll(context.Background(), &UserListOptions{ListOptions{PerPage: 10}})
+		assert.Nil(t, err)
+		assert.Len(t, users, 25)
+		assert.Equal(t, "24", users[24].GetUserID())
+	})
+	t.Run("Success max items", func(t *testing.T) {
+		client, teardown := newPaginationTestClient(25, 10)
+		defer teardown()
+
+		users, err := client.User.ListAll(context.Background(), &UserListOptions{ListOptions{PerPage: 10, MaxItems: 12}})
+		assert.Nil(t, err)
+		assert.Len(t, users, 12)
+	})
+	t.Run("Error canceled context", func(t *testing.T) {
+		client, teardown := newPaginationTestClient(25, 10)
+		defer teardown()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		users, err := client.User.ListAll(ctx, nil)
+		assert.Equal(t, context.Canceled, err)
+		assert.Len(t, users, 0)
+	})
+}
+
+func TestUserIterator_Next(t *testing.T) {
+	client, teardown := newPaginationTestClient(7, 3)
+	defer teardown()
+
+	it := client.User.Iterate(context.Background(), &UserListOptions{ListOptions{PerPage: 3}})
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.User().GetUserID())
+	}
+	assert.Nil(t, it.Err())
+	assert.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6"}, ids)
+}
+
+func TestCardTransactionService_ListAll(t *testing.T) {
+	// The server ignores pageNumber and always returns the same page.
+	calls := 0
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"cardtransactions":[{"cardtransactionId":"1"},{"cardtransactionId":"2"}]}`)
+	})
+	defer teardown()
+
+	txs, err := client.CardTransaction.ListAll(context.Background(), &CardTransactionsListOptions{ListOptions: ListOptions{PerPage: 2}})
+	assert.Nil(t, err)
+	assert.Len(t, txs, 2)
+	assert.Equal(t, 2, calls)
+}
+
+func TestBeneficiaryService_ListAll(t *testing.T) {
+	// The server ignores pageNumber, reports no totalRows and no pageCount is
+	// asked for: only the repeated first beneficiary stops the iteration.
+	calls := 0
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"beneficiaries":[{"id":"1"},{"id":"2"},{"id":"3"}]}`)
+	})
+	defer teardown()
+
+	beneficiaries, err := client.Beneficiary.ListAll(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Len(t, beneficiaries, 3)
+	assert.Equal(t, 2, calls)
+}
+
+func TestBalanceService_ListAll(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "42", r.URL.Query().Get("userId"))
+		switch r.URL.Query().Get("pageNumber") {
+		case "1":
+			fmt.Fprint(w, `{"balances":[{"walletId":"1"},{"walletId":"2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"balances":[{"walletId":"3"}]}`)
+		default:
+			fmt.Fprint(w, `{"balances":[]}`)
+		}
+	})
+	defer teardown()
+
+	balances, err := client.Balance.ListAll(context.Background(), &BalanceOptions{UserID: "42", ListOptions: ListOptions{PerPage: 2}})
+	assert.Nil(t, err)
+	if assert.Len(t, balances, 3) {
+		assert.Equal(t, "3", balances[2].GetWalletID())
+	}
+}
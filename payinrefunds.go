@@ -0,0 +1,148 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// PayinRefundService handles communication with the payinrefund related
+// methods of the Treezor API.
+//
+// Treezor API docs: https://www.treezor.com/api-documentation/#/payinrefund
+type PayinRefundService service
+
+// PayinRefundResponse represents a list of pay-in refunds.
+// It may contain only one item.
+type PayinRefundResponse struct {
+	PayinRefunds []*PayinRefund `json:"payinrefunds"`
+}
+
+// PayinRefund represents the refund of a pay-in.
+type PayinRefund struct {
+	Access
+	PayinRefundID     *string         `json:"payinrefundId,omitempty"`
+	PayinRefundTag    *string         `json:"payinrefundTag,omitempty"`
+	PayinRefundStatus *string         `json:"payinrefundStatus,omitempty"`
+	CodeStatus        *string         `json:"codeStatus,omitempty"`
+	InformationStatus *string         `json:"informationStatus,omitempty"`
+	WalletID          *string         `json:"walletId,omitempty"`
+	PayinID           *string         `json:"payinId,omitempty"`
+	Amount            *float64        `json:"amount,string,omitempty" money:"Currency,set"`
+	Currency          Currency        `json:"currency,omitempty"`
+	PayinRefundDate   *Date           `json:"payinrefundDate,omitempty"`
+	CreatedDate       *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate      *TimestampParis `json:"modifiedDate,omitempty"`
+	TotalRows         *int64          `json:"totalRows,string,omitempty"`
+}
+
+// Create creates a Treezor pay-in refund. The required field is PayinID.
+// The pay-in and Amount are checked before the refund is created; see refundAmount.
+func (s *PayinRefundService) Create(ctx context.Context, refund *PayinRefund) (*PayinRefund, *http.Response, error) {
+	if refund.GetPayinID() == "" {
+		return nil, nil, errors.New("pay-in refund requires a pay-in ID")
+	}
+
+	payin, resp, err := s.client.Payin.Get(ctx, refund.GetPayinID())
+	if err != nil {
+		return nil, resp, errors.Wrap(err, "getting the refunded pay-in")
+	}
+	if err := checkRefundable(payin.GetPayinStatus()); err != nil {
+		return nil, resp, err
+	}
+	refunds, err := s.ListAll(ctx, &PayinRefundListOptions{PayinID: refund.GetPayinID()})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "listing the refunds of the pay-in")
+	}
+	refunded := Money{Currency: payin.Currency}
+	for _, r := range refunds {
+		if refunded, err = addRefund(refunded, r.GetPayinRefundStatus(), r.AmountMoney()); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+	}
+	m, err := refundAmount(refund.Amount, refund.Currency, payin.AmountMoney(), refunded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := *refund
+	r.SetAmountMoney(m)
+	req, _ := s.client.NewRequest(http.MethodPost, "payinrefunds", &r)
+
+	b := new(PayinRefundResponse)
+	resp, err = s.client.Do(WithOperation(ctx, "PayinRefundService.Create"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.PayinRefunds) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one pay-in refund: %d pay-in refunds returned", len(b.PayinRefunds))
+	}
+	return b.PayinRefunds[0], resp, nil
+}
+
+// Get returns a pay-in refund.
+func (s *PayinRefundService) Get(ctx context.Context, payinRefundID string) (*PayinRefund, *http.Response, error) {
+	u := fmt.Sprintf("payinrefunds/%s", payinRefundID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(PayinRefundResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "PayinRefundService.Get"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.PayinRefunds) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one pay-in refund: %d pay-in refunds returned", len(b.PayinRefunds))
+	}
+	return b.PayinRefunds[0], resp, nil
+}
+
+// PayinRefundListOptions specifies the optional parameters to the PayinRefundService.List.
+type PayinRefundListOptions struct {
+	PayinRefundStatus string `url:"payinrefundStatus,omitempty"`
+	PayinID           string `url:"payinId,omitempty"`
+	WalletID          string `url:"walletId,omitempty"`
+	CreatedDateFrom   string `url:"createdDateFrom,omitempty"`
+	CreatedDateTo     string `url:"createdDateTo,omitempty"`
+
+	ListOptions
+}
+
+// List the pay-in refunds for the authenticated user.
+func (s *PayinRefundService) List(ctx context.Context, opt *PayinRefundListOptions) (*PayinRefundResponse, *http.Response, error) {
+	u := "payinrefunds"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(PayinRefundResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "PayinRefundService.List"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	return b, resp, nil
+}
+
+// Cancel cancels a pay-in refund. Its status is changed to CANCELED. A validated
+// pay-in refund can't be canceled.
+func (s *PayinRefundService) Cancel(ctx context.Context, payinRefundID string) (*PayinRefund, *http.Response, error) {
+	u := fmt.Sprintf("payinrefunds/%s", payinRefundID)
+	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
+
+	b := new(PayinRefundResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "PayinRefundService.Cancel"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.PayinRefunds) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one pay-in refund: %d pay-in refunds returned", len(b.PayinRefunds))
+	}
+	return b.PayinRefunds[0], resp, nil
+}
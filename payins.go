@@ -44,16 +44,16 @@ type Payin struct {
 	UserID               *string         `json:"userId,omitempty"`
 	WalletEventName      *string         `json:"walletEventName,omitempty"`
 	WalletAlias          *string         `json:"walletAlias,omitempty"`
-	UserFirstname        *string         `json:"userFirstname,omitempty"`
-	UserLastname         *string         `json:"userLastname,omitempty"`
+	UserFirstname        *string         `json:"userFirstname,omitempty" treezor:"secret"`
+	UserLastname         *string         `json:"userLastname,omitempty" treezor:"secret"`
 	MessageToUser        *string         `json:"messageToUser,omitempty"`
 	PaymentMethodID      *string         `json:"paymentMethodId,omitempty"`
-	SubtotalItems        *float64        `json:"subtotalItems,string,omitempty"`
-	SubtotalServices     *float64        `json:"subtotalServices,string,omitempty"`
-	SubtotalTax          *float64        `json:"subtotalTax,string,omitempty"`
-	Amount               *float64        `json:"amount,string,omitempty"`
+	SubtotalItems        *float64        `json:"subtotalItems,string,omitempty" money:"Currency"`
+	SubtotalServices     *float64        `json:"subtotalServices,string,omitempty" money:"Currency"`
+	SubtotalTax          *float64        `json:"subtotalTax,string,omitempty" money:"Currency"`
+	Amount               *float64        `json:"amount,string,omitempty" money:"Currency,set"`
 	Currency             Currency        `json:"currency,omitempty"`
-	DistributorFee       *float64        `json:"distributorFee,string,omitempty"`
+	DistributorFee       *float64        `json:"distributorFee,string,omitempty" money:"Currency"`
 	CreatedDate          *TimestampParis `json:"createdDate,omitempty"`
 	CreatedIP            *string         `json:"createdIp,omitempty"`
 	PaymentHTML          *string         `json:"paymentHtml,omitempty"`
@@ -65,13 +65,13 @@ type Payin struct {
 	PaymentRefusedURL    *string         `json:"paymentRefusedUrl,omitempty"`
 	PaymentCanceledURL   *string         `json:"paymentCanceledUrl,omitempty"`
 	PaymentExceptionURL  *string         `json:"paymentExceptionUrl,omitempty"`
-	DebitorIBAN          *string         `json:"DbtrIBAN,omitempty"`
-	IBANFullname         *string         `json:"ibanFullname,omitempty"`
+	DebitorIBAN          *string         `json:"DbtrIBAN,omitempty" treezor:"secret,last4"`
+	IBANFullname         *string         `json:"ibanFullname,omitempty" treezor:"secret"`
 	IBANID               *string         `json:"ibanId,omitempty"`
 	IBANBIC              *string         `json:"ibanBic,omitempty"`
 	IBANTxEndToEndID     *string         `json:"ibanTxEndToEndId,omitempty"`
 	IBANTxID             *string         `json:"ibanTxId,omitempty"`
-	RefundAmount         *float64        `json:"refundAmount,string,omitempty"`
+	RefundAmount         *float64        `json:"refundAmount,string,omitempty" money:"Currency"`
 	TotalRows            *int64          `json:"totalRows,string,omitempty"`
 	ForwardURL           *string         `json:"forwardUrl,omitempty"`
 	PayinDate            *Date           `json:"payinDate,omitempty"`
@@ -79,7 +79,7 @@ type Payin struct {
 	CreditorName         *string         `json:"creditorName,omitempty"`
 	CreditorAddressLine  *string         `json:"creditorAddressLine,omitempty"`
 	CreditorCountry      *string         `json:"creditorCountry,omitempty"`
-	CreditorIBAN         *string         `json:"creditorIban,omitempty"`
+	CreditorIBAN         *string         `json:"creditorIban,omitempty" treezor:"secret,last4"`
 	CreditorBIC          *string         `json:"creditorBIC,omitempty"`
 	VirtualIBANID        *string         `json:"virtualIbanId,omitempty"`
 	VirtualIBANReference *string         `json:"virtualIbanReference,omitempty"`
@@ -112,7 +112,7 @@ func (s *PayinService) Create(ctx context.Context, payin *Payin) (*Payin, *http.
 	req, _ := s.client.NewRequest(http.MethodPost, "payins", payin)
 
 	b := new(PayinResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "PayinService.Create"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -129,7 +129,7 @@ func (s *PayinService) Get(ctx context.Context, payinID string) (*Payin, *http.R
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(PayinResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "PayinService.Get"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -140,6 +140,18 @@ func (s *PayinService) Get(ctx context.Context, payinID string) (*Payin, *http.R
 	return b.Payins[0], resp, nil
 }
 
+// GetMany returns the pay-ins with the given IDs, in the same order,
+// fetching them concurrently. If some of them cannot be fetched, their entries
+// are nil and a *GetManyError holding the error of each ID is returned.
+func (s *PayinService) GetMany(ctx context.Context, payinIDs []string, opt *GetManyOptions) ([]*Payin, error) {
+	payins := make([]*Payin, len(payinIDs))
+	err := getMany(ctx, payinIDs, opt, func(ctx context.Context, i int) (err error) {
+		payins[i], _, err = s.Get(ctx, payinIDs[i])
+		return err
+	})
+	return payins, err
+}
+
 // PayinListOptions specifies the optional parameters to the PayinService.List.
 type PayinListOptions struct {
 	PayinStatus     string `url:"payinStatus,omitempty"`
@@ -161,7 +173,7 @@ func (s *PayinService) List(ctx context.Context, opt *PayinListOptions) (*PayinR
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(PayinResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "PayinService.List"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -175,7 +187,7 @@ func (s *PayinService) Delete(ctx context.Context, payinID string) (*Payin, *htt
 	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
 
 	b := new(PayinResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "PayinService.Delete"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -32,15 +32,15 @@ type Payout struct {
 	PayoutDate             *Date           `json:"payoutDate,omitempty"`
 	WalletEventName        *string         `json:"walletEventName,omitempty"`
 	WalletAlias            *string         `json:"walletAlias,omitempty"`
-	UserFirstname          *string         `json:"userFirstname,omitempty"`
-	UserLastname           *string         `json:"userLastname,omitempty"`
+	UserFirstname          *string         `json:"userFirstname,omitempty" treezor:"secret"`
+	UserLastname           *string         `json:"userLastname,omitempty" treezor:"secret"`
 	UserID                 *string         `json:"userId,omitempty"`
 	BeneficiaryID          *string         `json:"beneficiaryId,omitempty"`
 	UniqueMandateReference *string         `json:"uniqueMandateReference,omitempty"`
 	Label                  *string         `json:"label,omitempty"`
-	Amount                 *float64        `json:"amount,string,omitempty"`
+	Amount                 *float64        `json:"amount,string,omitempty" money:"Currency,set"`
 	Currency               Currency        `json:"currency,omitempty"`
-	PartnerFee             *float64        `json:"partnerFee,string,omitempty"`
+	PartnerFee             *float64        `json:"partnerFee,string,omitempty" money:"Currency"`
 	CreatedDate            *TimestampParis `json:"createdDate,omitempty"`
 	ModifiedDate           *TimestampParis `json:"modifiedDate,omitempty"`
 	TotalRows              *int64          `json:"totalRows,string,omitempty"`
@@ -48,11 +48,20 @@ type Payout struct {
 
 // Create creates a Treezor pay-out.
 // The required field are WalletID, BeneficiaryID, Amount, Currency(ISO 4217).
+// It returns ErrInvalidCurrency, without calling the API, if Currency is not a
+// known ISO 4217 code.
 func (s *PayoutService) Create(ctx context.Context, payout *Payout) (*Payout, *http.Response, error) {
+	if payout == nil {
+		return nil, nil, errors.New("missing pay-out")
+	}
+	if err := payout.Currency.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	req, _ := s.client.NewRequest(http.MethodPost, "payouts", payout)
 
 	b := new(PayoutResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "PayoutService.Create"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -69,7 +78,7 @@ func (s *PayoutService) Get(ctx context.Context, payoutID string) (*Payout, *htt
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(PayoutResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "PayoutService.Get"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -80,6 +89,18 @@ func (s *PayoutService) Get(ctx context.Context, payoutID string) (*Payout, *htt
 	return b.Payouts[0], resp, nil
 }
 
+// GetMany returns the pay-outs with the given IDs, in the same order,
+// fetching them concurrently. If some of them cannot be fetched, their entries
+// are nil and a *GetManyError holding the error of each ID is returned.
+func (s *PayoutService) GetMany(ctx context.Context, payoutIDs []string, opt *GetManyOptions) ([]*Payout, error) {
+	payouts := make([]*Payout, len(payoutIDs))
+	err := getMany(ctx, payoutIDs, opt, func(ctx context.Context, i int) (err error) {
+		payouts[i], _, err = s.Get(ctx, payoutIDs[i])
+		return err
+	})
+	return payouts, err
+}
+
 // PayoutListOptions specifies the optional parameters to the PayoutService.List.
 type PayoutListOptions struct {
 	PayoutStatus    string `url:"payoutStatus,omitempty"`
@@ -102,7 +123,7 @@ func (s *PayoutService) List(ctx context.Context, opt *PayoutListOptions) (*Payo
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(PayoutResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "PayoutService.List"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -116,7 +137,7 @@ func (s *PayoutService) Delete(ctx context.Context, payoutID string) (*Payout, *
 	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
 
 	b := new(PayoutResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "PayoutService.Delete"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
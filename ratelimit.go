@@ -0,0 +1,209 @@
+package treezor
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// minRateFactor is the lowest fraction of its configured rate a bucket
+	// slows down to after 429 responses.
+	minRateFactor = 0.1
+	// rateRecovery is the fraction of its configured rate a slowed down
+	// bucket recovers after each successful response.
+	rateRecovery = 0.05
+)
+
+// Limit is the rate of requests allowed by a token bucket.
+type Limit struct {
+	// Rate is the number of requests allowed per second.
+	Rate float64
+
+	// Burst is the number of requests that can be sent at once.
+	// Defaults to Rate, and at least 1, if zero.
+	Burst int
+}
+
+// RateLimiter throttles the requests sent by Client.Do with token buckets, so
+// that bulk jobs stay within the Treezor quotas. Every request, retries
+// included, takes a token from the bucket of the client and one from the
+// bucket of its endpoint group, waiting for them if needed.
+//
+// Endpoint groups are named after the services, e.g. "CardTransactionService"
+// for CardTransactionService.List. Requests sent outside of the services are
+// only limited by the bucket of the client.
+//
+// When the API answers 429 Too Many Requests, the buckets used by the request
+// halve their rate, down to a tenth of their configured rate, then recover
+// progressively with each successful response.
+//
+// A RateLimiter may be shared by several clients. Its fields must not be
+// modified once it is in use.
+type RateLimiter struct {
+	// Limit is the limit of all the requests. Requests are only limited by
+	// their group if its Rate is zero.
+	Limit
+
+	// Groups are the limits of the endpoint groups.
+	Groups map[string]Limit
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time // Defaults to time.Now.
+}
+
+// RateStats describes the state of a token bucket of a RateLimiter.
+type RateStats struct {
+	Limit       Limit   // Configured limit.
+	Rate        float64 // Current rate, lower than Limit.Rate after 429s.
+	Tokens      float64 // Available tokens, negative if requests are waiting.
+	Utilization float64 // Fraction of the burst in use, above 1 if requests are waiting.
+}
+
+type bucket struct {
+	limit  Limit
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func (l *RateLimiter) clock() time.Time {
+	if l.now == nil {
+		return time.Now()
+	}
+	return l.now()
+}
+
+// groupOf returns the endpoint group of op.
+func groupOf(op *Operation) string {
+	if i := strings.Index(op.Name, "."); i > 0 {
+		return op.Name[:i]
+	}
+	return ""
+}
+
+// bucketsFor returns the buckets limiting op. It must be called with l.mu held.
+func (l *RateLimiter) bucketsFor(op *Operation, now time.Time) []*bucket {
+	if l.buckets == nil {
+		l.buckets = map[string]*bucket{}
+	}
+	var bs []*bucket
+	keys := []string{""}
+	if g := groupOf(op); g != "" {
+		keys = append(keys, g)
+	}
+	for _, key := range keys {
+		limit := l.Limit
+		if key != "" {
+			limit = l.Groups[key]
+		}
+		if limit.Rate <= 0 {
+			continue
+		}
+		b, ok := l.buckets[key]
+		if !ok {
+			b = &bucket{limit: limit, rate: limit.Rate, last: now}
+			b.tokens = float64(b.burst())
+			l.buckets[key] = b
+		}
+		bs = append(bs, b)
+	}
+	return bs
+}
+
+func (b *bucket) burst() int {
+	if b.limit.Burst > 0 {
+		return b.limit.Burst
+	}
+	return int(math.Max(1, math.Ceil(b.limit.Rate)))
+}
+
+// refill adds the tokens accumulated since the last refill.
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(b.burst()), b.tokens+elapsed*b.rate)
+	}
+	b.last = now
+}
+
+// wait takes a token for op from each of its buckets, waiting until they are
+// available. If ctx would expire before, it returns at once with an error
+// wrapping context.DeadlineExceeded and takes no token.
+func (l *RateLimiter) wait(ctx context.Context, op *Operation) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	now := l.clock()
+	bs := l.bucketsFor(op, now)
+	var delay time.Duration
+	for _, b := range bs {
+		b.refill(now)
+		if b.tokens < 1 {
+			if d := time.Duration((1 - b.tokens) / b.rate * float64(time.Second)); d > delay {
+				delay = d
+			}
+		}
+	}
+	if deadline, ok := ctx.Deadline(); ok && delay > 0 && now.Add(delay).After(deadline) {
+		l.mu.Unlock()
+		return errors.Wrapf(context.DeadlineExceeded, "rate limiter: waiting %v would exceed the context deadline", delay)
+	}
+	for _, b := range bs {
+		b.tokens--
+	}
+	l.mu.Unlock()
+
+	if err := sleepContext(ctx, delay); err != nil {
+		l.mu.Lock()
+		for _, b := range bs {
+			b.tokens++ // Give back the tokens.
+		}
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// observe adapts the rate of the buckets of op to the response of a request.
+func (l *RateLimiter) observe(op *Operation, resp *http.Response) {
+	if l == nil || resp == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock()
+	for _, b := range l.bucketsFor(op, now) {
+		b.refill(now)
+		if resp.StatusCode == http.StatusTooManyRequests {
+			b.rate = math.Max(b.rate/2, b.limit.Rate*minRateFactor)
+		} else if resp.StatusCode < http.StatusInternalServerError {
+			b.rate = math.Min(b.rate+b.limit.Rate*rateRecovery, b.limit.Rate)
+		}
+	}
+}
+
+// Stats returns the state of the buckets used so far, by endpoint group. The
+// bucket of all the requests is keyed by "".
+func (l *RateLimiter) Stats() map[string]RateStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock()
+	stats := make(map[string]RateStats, len(l.buckets))
+	for key, b := range l.buckets {
+		b.refill(now)
+		stats[key] = RateStats{
+			Limit:       b.limit,
+			Rate:        b.rate,
+			Tokens:      b.tokens,
+			Utilization: 1 - b.tokens/float64(b.burst()),
+		}
+	}
+	return stats
+}
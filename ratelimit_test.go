@@ -0,0 +1,81 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := &RateLimiter{
+		Limit:  Limit{Rate: 10, Burst: 2},
+		Groups: map[string]Limit{"BalanceService": {Rate: 1}},
+		now:    func() time.Time { return now },
+	}
+	balances := &Operation{Name: "BalanceService.List"}
+	cards := &Operation{Name: "CardService.Get"}
+	ctx := context.Background()
+
+	t.Run("Burst", func(t *testing.T) {
+		assert.Nil(t, l.wait(ctx, cards))
+		assert.Nil(t, l.wait(ctx, balances))
+		stats := l.Stats()
+		assert.Equal(t, 1.0, stats[""].Utilization)
+		assert.Equal(t, 0.0, stats["BalanceService"].Tokens)
+		_, ok := stats["CardService"]
+		assert.False(t, ok)
+	})
+	t.Run("Deadline", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(ctx, now.Add(500*time.Millisecond))
+		defer cancel()
+		err := l.wait(ctx, balances)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, 0.0, l.Stats()["BalanceService"].Tokens)
+	})
+	t.Run("Refill", func(t *testing.T) {
+		now = now.Add(time.Second)
+		assert.Nil(t, l.wait(ctx, balances))
+		assert.Equal(t, 0.0, l.Stats()["BalanceService"].Tokens)
+	})
+	t.Run("Adaptive slowdown", func(t *testing.T) {
+		l.observe(balances, &http.Response{StatusCode: http.StatusTooManyRequests})
+		l.observe(balances, &http.Response{StatusCode: http.StatusTooManyRequests})
+		assert.Equal(t, 0.25, l.Stats()["BalanceService"].Rate)
+		assert.Equal(t, 2.5, l.Stats()[""].Rate)
+		for i := 0; i < 100; i++ {
+			l.observe(balances, &http.Response{StatusCode: http.StatusOK})
+		}
+		assert.Equal(t, 1.0, l.Stats()["BalanceService"].Rate)
+		assert.Equal(t, 10.0, l.Stats()[""].Rate)
+	})
+}
+
+func TestClient_Do_RateLimiter(t *testing.T) {
+	var calls int32
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{"balances":[]}`))
+	})
+	defer teardown()
+	client.RateLimiter = &RateLimiter{Groups: map[string]Limit{"BalanceService": {Rate: 20, Burst: 1}}}
+
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, _, err := client.Balance.List(ctx, nil)
+		assert.Nil(t, err)
+	}
+	assert.True(t, time.Since(start) >= 90*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, _, err := client.Balance.List(ctx, nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,199 @@
+package treezor
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Redacted replaces the values of the secret fields in redacted values.
+const Redacted = "REDACTED"
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// Redact returns a copy of v safe to log: structs are converted to maps keyed
+// by their JSON field names, nil fields are omitted and the values of the
+// fields tagged with `treezor:"secret"`, such as Card.CVV or User.Birthday,
+// are masked.
+func Redact(v interface{}) interface{} {
+	return redactValue(reflect.ValueOf(v))
+}
+
+func redactValue(v reflect.Value) interface{} {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil
+	}
+
+	// Values with their own JSON encoding, such as dates, are kept as encoded.
+	if v.Type().Implements(jsonMarshalerType) || (v.CanAddr() && v.Addr().Type().Implements(jsonMarshalerType)) {
+		if v.CanAddr() {
+			v = v.Addr()
+		}
+		var out interface{}
+		if data, err := json.Marshal(v.Interface()); err == nil && json.Unmarshal(data, &out) == nil {
+			return out
+		}
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		m := map[string]interface{}{}
+		redactStruct(v, m)
+		return m
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		s := make([]interface{}, v.Len())
+		for i := range s {
+			s[i] = redactValue(v.Index(i))
+		}
+		return s
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := map[string]interface{}{}
+		iter := v.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = redactValue(iter.Value())
+		}
+		return m
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	}
+	return v.Interface()
+}
+
+// redactStruct adds the fields of the struct v to m. The fields of embedded
+// structs are added as if they were fields of v, as encoding/json does.
+func redactStruct(v reflect.Value, m map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fv := v.Field(i)
+		name, opts := parseJSONTag(f)
+		if name == "-" && opts == "" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			for fv.Kind() == reflect.Ptr && !fv.IsNil() {
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				redactStruct(fv, m)
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue // Unexported field.
+		}
+		if isNilValue(fv) || (strings.Contains(opts, "omitempty") && isEmptyValue(fv)) {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if mask, ok := fieldMask(f); ok {
+			m[name] = maskValue(fv, mask)
+			continue
+		}
+		m[name] = redactValue(fv)
+	}
+}
+
+func parseJSONTag(f reflect.StructField) (name, opts string) {
+	tag := f.Tag.Get("json")
+	if i := strings.Index(tag, ","); i >= 0 {
+		return tag[:i], tag[i+1:]
+	}
+	return tag, ""
+}
+
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return v.IsNil()
+	}
+	return false
+}
+
+// isEmptyValue reports whether v is empty in the sense of the omitempty
+// option of encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+// fieldMask returns the function masking the values of f, if f is tagged
+// with `treezor:"secret"`. The values of the fields also tagged with the last4
+// option, such as IBANs, are masked but for their last four characters; the
+// values of the other secret fields are replaced by Redacted.
+func fieldMask(f reflect.StructField) (func(string) string, bool) {
+	secret, last4 := false, false
+	for _, opt := range strings.Split(f.Tag.Get("treezor"), ",") {
+		switch opt {
+		case "secret":
+			secret = true
+		case "last4":
+			last4 = true
+		}
+	}
+	switch {
+	case !secret:
+		return nil, false
+	case last4:
+		return maskLast4, true
+	}
+	return maskAll, true
+}
+
+func maskAll(string) string {
+	return Redacted
+}
+
+// maskLast4 masks s but for its last four characters, e.g. "****0185" for an
+// IBAN. Strings too short to keep anything are fully masked.
+func maskLast4(s string) string {
+	r := []rune(s)
+	if len(r) <= 8 {
+		return Redacted
+	}
+	return "****" + string(r[len(r)-4:])
+}
+
+// maskValue returns the masked form of the value of a masked field. Only
+// strings are partially masked.
+func maskValue(v reflect.Value, mask func(string) string) string {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return Redacted
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.String {
+		return Redacted
+	}
+	return mask(v.String())
+}
@@ -0,0 +1,85 @@
+package treezor
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// ErrRefundExceedsAmount is returned when a refund is greater than the amount
+// of the refunded operation still to be refunded.
+var ErrRefundExceedsAmount = errors.New("refund exceeds the refundable amount")
+
+// ErrNotRefundable is returned when the status of an operation does not allow
+// to refund it.
+var ErrNotRefundable = errors.New("operation cannot be refunded")
+
+// refundStatusCanceled is the status of a canceled pay-in or transfer refund.
+const refundStatusCanceled = "CANCELED"
+
+// refundableStatus is the status of the pay-ins and transfers which can be
+// refunded. Pending and canceled operations cannot.
+const refundableStatus = "VALIDATED"
+
+// checkRefundable returns ErrNotRefundable unless status, the status of a
+// pay-in or transfer, allows to refund it.
+func checkRefundable(status string) error {
+	if status != refundableStatus {
+		return errors.Wrapf(ErrNotRefundable, "status %q", status)
+	}
+	return nil
+}
+
+// addRefund adds the amount of a refund with the given status to refunded,
+// unless the refund was canceled. The refund services compute what was already
+// refunded of an operation this way, from the list of its refunds.
+func addRefund(refunded Money, status string, amount Money) (Money, error) {
+	if status == refundStatusCanceled {
+		return refunded, nil
+	}
+	m, err := refunded.Add(amount)
+	return m, errors.WithStack(err)
+}
+
+// refundAmount returns the amount of a refund of an operation of the given
+// amount, of which refunded was already refunded. A nil refund asks for the
+// whole refundable amount. It returns ErrRefundExceedsAmount if refund is
+// greater than the refundable amount, and an error if it has more decimals
+// than currency allows, rather than rounding it.
+//
+// The refund services call checkRefundable and refundAmount before creating a
+// refund, from the operation and its refunds listed just before. The check is
+// best-effort only: a refund created concurrently, between the listing and
+// the creation, is not accounted for. Treezor remains the authority on what
+// can be refunded.
+func refundAmount(refund *float64, currency Currency, amount, refunded Money) (Money, error) {
+	refundable, err := amount.Sub(refunded)
+	if err != nil {
+		return Money{}, errors.WithStack(err)
+	}
+	if refund == nil {
+		if !refundable.IsNegative() && !refundable.IsZero() {
+			return refundable, nil
+		}
+		return Money{}, errors.Wrapf(ErrRefundExceedsAmount, "%v already refunded out of %v", refunded, amount)
+	}
+
+	if currency == "" {
+		currency = amount.Currency
+	}
+	m, err := ParseMoney(strconv.FormatFloat(*refund, 'f', -1, 64), currency)
+	if err != nil {
+		return Money{}, errors.Wrap(err, "refund amount")
+	}
+	if m.IsNegative() || m.IsZero() {
+		return Money{}, errors.Errorf("refund amount must be positive, got %v", m)
+	}
+	cmp, err := m.Cmp(refundable)
+	if err != nil {
+		return Money{}, errors.WithStack(err)
+	}
+	if cmp > 0 {
+		return Money{}, errors.Wrapf(ErrRefundExceedsAmount, "refund of %v with %v refundable", m, refundable)
+	}
+	return m, nil
+}
@@ -0,0 +1,161 @@
+package treezor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefundAmount(t *testing.T) {
+	amount := NewMoney(10000, EUR)
+
+	m, err := refundAmount(Float64(25.5), EUR, amount, NewMoney(5000, EUR))
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(2550, EUR), m)
+
+	m, err = refundAmount(Float64(50), "", amount, NewMoney(5000, EUR))
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(5000, EUR), m, "the currency defaults to the one of the operation")
+
+	m, err = refundAmount(nil, "", amount, NewMoney(2500, EUR))
+	require.NoError(t, err)
+	assert.Equal(t, NewMoney(7500, EUR), m, "a nil refund refunds what is left")
+
+	_, err = refundAmount(Float64(50.01), EUR, amount, NewMoney(5000, EUR))
+	assert.True(t, errors.Is(err, ErrRefundExceedsAmount))
+
+	_, err = refundAmount(nil, "", amount, amount)
+	assert.True(t, errors.Is(err, ErrRefundExceedsAmount))
+
+	_, err = refundAmount(Float64(10), USD, amount, Money{Currency: EUR})
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+
+	_, err = refundAmount(Float64(0), EUR, amount, Money{Currency: EUR})
+	assert.Error(t, err)
+
+	_, err = refundAmount(Float64(10.005), EUR, amount, Money{Currency: EUR})
+	assert.Error(t, err, "too many decimals are not rounded")
+}
+
+func TestCheckRefundable(t *testing.T) {
+	assert.Nil(t, checkRefundable("VALIDATED"))
+	for _, status := range []string{"PENDING", "CANCELED", ""} {
+		assert.True(t, errors.Is(checkRefundable(status), ErrNotRefundable), status)
+	}
+}
+
+func TestPayinRefundService_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		amount   *float64
+		err      error
+		requests []string
+	}{
+		{"Success", "VALIDATED", Float64(60), nil, []string{
+			"GET /payins/1",
+			"GET /payinrefunds?pageNumber=1&payinId=1",
+			"POST /payinrefunds",
+		}},
+		{"Error refund exceeds amount", "VALIDATED", Float64(70.01), ErrRefundExceedsAmount, []string{
+			"GET /payins/1",
+			"GET /payinrefunds?pageNumber=1&payinId=1",
+		}},
+		{"Error not refundable", "PENDING", nil, ErrNotRefundable, []string{"GET /payins/1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			var body map[string]interface{}
+			mux := http.NewServeMux()
+			mux.HandleFunc("/payins/1", func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.RequestURI())
+				fmt.Fprintf(w, `{"payins":[{"payinStatus":%q,"amount":"100.00","currency":"EUR"}]}`, tt.status)
+			})
+			mux.HandleFunc("/payinrefunds", func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.RequestURI())
+				if r.Method == http.MethodPost {
+					assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+					w.Write([]byte(`{"payinrefunds":[{"amount":"60.00","currency":"EUR"}]}`))
+					return
+				}
+				// 30 EUR already refunded; the canceled refund does not count.
+				w.Write([]byte(`{"payinrefunds":[{"payinrefundStatus":"VALIDATED","amount":"30.00","currency":"EUR","totalRows":"2"},{"payinrefundStatus":"CANCELED","amount":"50.00","currency":"EUR","totalRows":"2"}]}`))
+			})
+			client, teardown := newTestClient(mux.ServeHTTP)
+			defer teardown()
+
+			r, _, err := client.PayinRefund.Create(context.Background(), &PayinRefund{PayinID: String("1"), Amount: tt.amount})
+			assert.Equal(t, tt.requests, requests)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, NewMoney(6000, EUR), r.AmountMoney())
+			assert.Equal(t, "60", body["amount"])
+			assert.Equal(t, "EUR", body["currency"])
+		})
+	}
+}
+
+func TestTransferRefundService_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		amount   *float64
+		err      error
+		requests []string
+	}{
+		{"Success", "VALIDATED", Float64(60), nil, []string{
+			"GET /transfers/1",
+			"GET /transferrefunds?pageNumber=1&transferId=1",
+			"POST /transferrefunds",
+		}},
+		{"Error refund exceeds amount", "VALIDATED", Float64(70.01), ErrRefundExceedsAmount, []string{
+			"GET /transfers/1",
+			"GET /transferrefunds?pageNumber=1&transferId=1",
+		}},
+		{"Error not refundable", "PENDING", nil, ErrNotRefundable, []string{"GET /transfers/1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			var body map[string]interface{}
+			mux := http.NewServeMux()
+			mux.HandleFunc("/transfers/1", func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.RequestURI())
+				fmt.Fprintf(w, `{"transfers":[{"transferStatus":%q,"amount":"100.00","currency":"EUR"}]}`, tt.status)
+			})
+			mux.HandleFunc("/transferrefunds", func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.RequestURI())
+				if r.Method == http.MethodPost {
+					assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+					w.Write([]byte(`{"transferrefunds":[{"amount":"60.00","currency":"EUR"}]}`))
+					return
+				}
+				// 30 EUR already refunded; the canceled refund does not count.
+				w.Write([]byte(`{"transferrefunds":[{"transferrefundStatus":"VALIDATED","amount":"30.00","currency":"EUR","totalRows":"2"},{"transferrefundStatus":"CANCELED","amount":"50.00","currency":"EUR","totalRows":"2"}]}`))
+			})
+			client, teardown := newTestClient(mux.ServeHTTP)
+			defer teardown()
+
+			r, _, err := client.TransferRefund.Create(context.Background(), &TransferRefund{TransferID: String("1"), Amount: tt.amount})
+			assert.Equal(t, tt.requests, requests)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, NewMoney(6000, EUR), r.AmountMoney())
+			assert.Equal(t, "60", body["amount"])
+			assert.Equal(t, "EUR", body["currency"])
+		})
+	}
+}
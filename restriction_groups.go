@@ -0,0 +1,432 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// MCCGroupService handles communication with the MCC restriction group
+// related methods of the Treezor API.
+//
+// Treezor API docs: https://www.treezor.com/api-documentation/#/mccrestrictiongroup
+type MCCGroupService service
+
+// MerchantIDGroupService handles communication with the merchant ID
+// restriction group related methods of the Treezor API.
+//
+// Treezor API docs: https://www.treezor.com/api-documentation/#/merchantidrestrictiongroup
+type MerchantIDGroupService service
+
+// CountryGroupService handles communication with the country restriction
+// group related methods of the Treezor API.
+//
+// Treezor API docs: https://www.treezor.com/api-documentation/#/countryrestrictiongroup
+type CountryGroupService service
+
+// Treezor restriction group status
+const (
+	RestrictionGroupStatusPending   = "PENDING"
+	RestrictionGroupStatusValidated = "VALIDATED"
+	RestrictionGroupStatusCanceled  = "CANCELED"
+)
+
+// RestrictionMode tells whether a card may only pay at the members of a
+// restriction group, or anywhere but at them.
+type RestrictionMode string
+
+// List of RestrictionMode.
+const (
+	// RestrictionAllow allows the members of the group only.
+	RestrictionAllow RestrictionMode = "allow"
+	// RestrictionDeny denies the members of the group.
+	RestrictionDeny RestrictionMode = "deny"
+)
+
+// restrictionMode returns the mode of a group from its isWhitelist field.
+// Groups are whitelists unless told otherwise.
+func restrictionMode(isWhitelist *bool) RestrictionMode {
+	if isWhitelist != nil && !*isWhitelist {
+		return RestrictionDeny
+	}
+	return RestrictionAllow
+}
+
+// isWhitelist returns the isWhitelist field of a group in mode m.
+func (m RestrictionMode) isWhitelist() *bool {
+	return Bool(m != RestrictionDeny)
+}
+
+// MCCGroupResponse represents a list of MCC restriction groups.
+// It may contain only one item.
+type MCCGroupResponse struct {
+	MCCGroups []*MCCGroup `json:"mccRestrictionGroups"`
+}
+
+// MCCGroup represents a group of Merchant Category Codes which a card is
+// allowed or forbidden to pay at.
+type MCCGroup struct {
+	ID           *int64          `json:"id,omitempty"`
+	Name         *string         `json:"name,omitempty"`
+	IsWhitelist  *bool           `json:"isWhitelist,omitempty"`
+	MCC          []int64         `json:"mcc,omitempty"`
+	Status       *string         `json:"status,omitempty"`
+	StartDate    *TimestampParis `json:"startDate,omitempty"`
+	CreatedDate  *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate *TimestampParis `json:"modifiedDate,omitempty"`
+}
+
+// Mode returns the restriction mode of the group, from IsWhitelist.
+func (g *MCCGroup) Mode() RestrictionMode {
+	return restrictionMode(g.IsWhitelist)
+}
+
+// SetMode sets IsWhitelist from the restriction mode m.
+func (g *MCCGroup) SetMode(m RestrictionMode) {
+	g.IsWhitelist = m.isWhitelist()
+}
+
+// Create creates a Treezor MCC restriction group.
+// The required fields are Name and MCC. The group restricts the cards from StartDate.
+func (s *MCCGroupService) Create(ctx context.Context, group *MCCGroup) (*MCCGroup, *http.Response, error) {
+	req, _ := s.client.NewRequest(http.MethodPost, "mccRestrictionGroups", group)
+
+	b := new(MCCGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MCCGroupService.Create"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.MCCGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one MCC restriction group: %d MCC restriction groups returned", len(b.MCCGroups))
+	}
+	return b.MCCGroups[0], resp, nil
+}
+
+// Get returns a MCC restriction group.
+func (s *MCCGroupService) Get(ctx context.Context, groupID int64) (*MCCGroup, *http.Response, error) {
+	u := fmt.Sprintf("mccRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(MCCGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MCCGroupService.Get"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.MCCGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one MCC restriction group: %d MCC restriction groups returned", len(b.MCCGroups))
+	}
+	return b.MCCGroups[0], resp, nil
+}
+
+// MCCGroupListOptions specifies the optional parameters to the MCCGroupService.List.
+type MCCGroupListOptions struct {
+	Name   string `url:"name,omitempty"`
+	Status string `url:"status,omitempty"`
+
+	ListOptions
+}
+
+// List the MCC restriction groups for the authenticated user.
+func (s *MCCGroupService) List(ctx context.Context, opt *MCCGroupListOptions) (*MCCGroupResponse, *http.Response, error) {
+	u := "mccRestrictionGroups"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(MCCGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MCCGroupService.List"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	return b, resp, nil
+}
+
+// Edit updates the MCC restriction group groupID.
+func (s *MCCGroupService) Edit(ctx context.Context, groupID int64, group *MCCGroup) (*MCCGroup, *http.Response, error) {
+	u := fmt.Sprintf("mccRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodPut, u, group)
+
+	b := new(MCCGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MCCGroupService.Edit"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.MCCGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one MCC restriction group: %d MCC restriction groups returned", len(b.MCCGroups))
+	}
+	return b.MCCGroups[0], resp, nil
+}
+
+// Cancel cancels a MCC restriction group. Its status is changed to CANCELED and it no
+// longer restricts the cards.
+func (s *MCCGroupService) Cancel(ctx context.Context, groupID int64) (*MCCGroup, *http.Response, error) {
+	u := fmt.Sprintf("mccRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
+
+	b := new(MCCGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MCCGroupService.Cancel"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.MCCGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one MCC restriction group: %d MCC restriction groups returned", len(b.MCCGroups))
+	}
+	return b.MCCGroups[0], resp, nil
+}
+
+// CountryGroupResponse represents a list of country restriction groups.
+// It may contain only one item.
+type CountryGroupResponse struct {
+	CountryGroups []*CountryGroup `json:"countryRestrictionGroups"`
+}
+
+// CountryGroup represents a group of countries where a card is allowed or
+// forbidden to pay.
+type CountryGroup struct {
+	ID           *int64          `json:"id,omitempty"`
+	Name         *string         `json:"name,omitempty"`
+	IsWhitelist  *bool           `json:"isWhitelist,omitempty"`
+	Countries    []string        `json:"countries,omitempty"`
+	Status       *string         `json:"status,omitempty"`
+	StartDate    *TimestampParis `json:"startDate,omitempty"`
+	CreatedDate  *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate *TimestampParis `json:"modifiedDate,omitempty"`
+}
+
+// Mode returns the restriction mode of the group, from IsWhitelist.
+func (g *CountryGroup) Mode() RestrictionMode {
+	return restrictionMode(g.IsWhitelist)
+}
+
+// SetMode sets IsWhitelist from the restriction mode m.
+func (g *CountryGroup) SetMode(m RestrictionMode) {
+	g.IsWhitelist = m.isWhitelist()
+}
+
+// Create creates a Treezor country restriction group.
+// The required fields are Name and Countries. The group restricts the cards from StartDate.
+func (s *CountryGroupService) Create(ctx context.Context, group *CountryGroup) (*CountryGroup, *http.Response, error) {
+	req, _ := s.client.NewRequest(http.MethodPost, "countryRestrictionGroups", group)
+
+	b := new(CountryGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "CountryGroupService.Create"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.CountryGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one country restriction group: %d country restriction groups returned", len(b.CountryGroups))
+	}
+	return b.CountryGroups[0], resp, nil
+}
+
+// Get returns a country restriction group.
+func (s *CountryGroupService) Get(ctx context.Context, groupID int64) (*CountryGroup, *http.Response, error) {
+	u := fmt.Sprintf("countryRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(CountryGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "CountryGroupService.Get"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.CountryGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one country restriction group: %d country restriction groups returned", len(b.CountryGroups))
+	}
+	return b.CountryGroups[0], resp, nil
+}
+
+// CountryGroupListOptions specifies the optional parameters to the CountryGroupService.List.
+type CountryGroupListOptions struct {
+	Name   string `url:"name,omitempty"`
+	Status string `url:"status,omitempty"`
+
+	ListOptions
+}
+
+// List the country restriction groups for the authenticated user.
+func (s *CountryGroupService) List(ctx context.Context, opt *CountryGroupListOptions) (*CountryGroupResponse, *http.Response, error) {
+	u := "countryRestrictionGroups"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(CountryGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "CountryGroupService.List"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	return b, resp, nil
+}
+
+// Edit updates the country restriction group groupID.
+func (s *CountryGroupService) Edit(ctx context.Context, groupID int64, group *CountryGroup) (*CountryGroup, *http.Response, error) {
+	u := fmt.Sprintf("countryRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodPut, u, group)
+
+	b := new(CountryGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "CountryGroupService.Edit"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.CountryGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one country restriction group: %d country restriction groups returned", len(b.CountryGroups))
+	}
+	return b.CountryGroups[0], resp, nil
+}
+
+// Cancel cancels a country restriction group. Its status is changed to CANCELED and it no
+// longer restricts the cards.
+func (s *CountryGroupService) Cancel(ctx context.Context, groupID int64) (*CountryGroup, *http.Response, error) {
+	u := fmt.Sprintf("countryRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
+
+	b := new(CountryGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "CountryGroupService.Cancel"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.CountryGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one country restriction group: %d country restriction groups returned", len(b.CountryGroups))
+	}
+	return b.CountryGroups[0], resp, nil
+}
+
+// MerchantIDGroupResponse represents a list of merchant ID restriction groups.
+// It may contain only one item.
+type MerchantIDGroupResponse struct {
+	MerchantIDGroups []*MerchantIDGroup `json:"merchantIdRestrictionGroups"`
+}
+
+// MerchantIDGroup represents a group of merchant IDs which a card is allowed
+// or forbidden to pay at.
+type MerchantIDGroup struct {
+	ID           *int64          `json:"id,omitempty"`
+	Name         *string         `json:"name,omitempty"`
+	IsWhitelist  *bool           `json:"isWhitelist,omitempty"`
+	Merchants    []string        `json:"merchants,omitempty"`
+	Status       *string         `json:"status,omitempty"`
+	StartDate    *TimestampParis `json:"startDate,omitempty"`
+	CreatedDate  *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate *TimestampParis `json:"modifiedDate,omitempty"`
+}
+
+// Mode returns the restriction mode of the group, from IsWhitelist.
+func (g *MerchantIDGroup) Mode() RestrictionMode {
+	return restrictionMode(g.IsWhitelist)
+}
+
+// SetMode sets IsWhitelist from the restriction mode m.
+func (g *MerchantIDGroup) SetMode(m RestrictionMode) {
+	g.IsWhitelist = m.isWhitelist()
+}
+
+// Create creates a Treezor merchant ID restriction group.
+// The required fields are Name and Merchants. The group restricts the cards from StartDate.
+func (s *MerchantIDGroupService) Create(ctx context.Context, group *MerchantIDGroup) (*MerchantIDGroup, *http.Response, error) {
+	req, _ := s.client.NewRequest(http.MethodPost, "merchantIdRestrictionGroups", group)
+
+	b := new(MerchantIDGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MerchantIDGroupService.Create"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.MerchantIDGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one merchant ID restriction group: %d merchant ID restriction groups returned", len(b.MerchantIDGroups))
+	}
+	return b.MerchantIDGroups[0], resp, nil
+}
+
+// Get returns a merchant ID restriction group.
+func (s *MerchantIDGroupService) Get(ctx context.Context, groupID int64) (*MerchantIDGroup, *http.Response, error) {
+	u := fmt.Sprintf("merchantIdRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(MerchantIDGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MerchantIDGroupService.Get"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.MerchantIDGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one merchant ID restriction group: %d merchant ID restriction groups returned", len(b.MerchantIDGroups))
+	}
+	return b.MerchantIDGroups[0], resp, nil
+}
+
+// MerchantIDGroupListOptions specifies the optional parameters to the MerchantIDGroupService.List.
+type MerchantIDGroupListOptions struct {
+	Name   string `url:"name,omitempty"`
+	Status string `url:"status,omitempty"`
+
+	ListOptions
+}
+
+// List the merchant ID restriction groups for the authenticated user.
+func (s *MerchantIDGroupService) List(ctx context.Context, opt *MerchantIDGroupListOptions) (*MerchantIDGroupResponse, *http.Response, error) {
+	u := "merchantIdRestrictionGroups"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(MerchantIDGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MerchantIDGroupService.List"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	return b, resp, nil
+}
+
+// Edit updates the merchant ID restriction group groupID.
+func (s *MerchantIDGroupService) Edit(ctx context.Context, groupID int64, group *MerchantIDGroup) (*MerchantIDGroup, *http.Response, error) {
+	u := fmt.Sprintf("merchantIdRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodPut, u, group)
+
+	b := new(MerchantIDGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MerchantIDGroupService.Edit"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.MerchantIDGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one merchant ID restriction group: %d merchant ID restriction groups returned", len(b.MerchantIDGroups))
+	}
+	return b.MerchantIDGroups[0], resp, nil
+}
+
+// Cancel cancels a merchant ID restriction group. Its status is changed to CANCELED and it no
+// longer restricts the cards.
+func (s *MerchantIDGroupService) Cancel(ctx context.Context, groupID int64) (*MerchantIDGroup, *http.Response, error) {
+	u := fmt.Sprintf("merchantIdRestrictionGroups/%d", groupID)
+	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
+
+	b := new(MerchantIDGroupResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "MerchantIDGroupService.Cancel"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.MerchantIDGroups) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one merchant ID restriction group: %d merchant ID restriction groups returned", len(b.MerchantIDGroups))
+	}
+	return b.MerchantIDGroups[0], resp, nil
+}
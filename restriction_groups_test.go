@@ -0,0 +1,204 @@
+package treezor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMCCGroupService(t *testing.T) {
+	var requests []string
+	var body map[string]interface{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body = nil
+		status := RestrictionGroupStatusValidated
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		case http.MethodDelete:
+			status = RestrictionGroupStatusCanceled
+		}
+		fmt.Fprintf(w, `{"mccRestrictionGroups":[{"id":1,"isWhitelist":false,"status":%q}]}`, status)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/mccRestrictionGroups", handler)
+	mux.HandleFunc("/mccRestrictionGroups/1", handler)
+	client, teardown := newTestClient(mux.ServeHTTP)
+	defer teardown()
+	ctx := context.Background()
+
+	group := &MCCGroup{Name: String("food"), MCC: []int64{5411, 5812}}
+	group.SetMode(RestrictionDeny)
+	g, _, err := client.MCCGroup.Create(ctx, group)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), g.GetID())
+	assert.Equal(t, RestrictionDeny, g.Mode())
+	assert.Equal(t, map[string]interface{}{"name": "food", "isWhitelist": false, "mcc": []interface{}{5411.0, 5812.0}}, body)
+
+	_, _, err = client.MCCGroup.Get(ctx, 1)
+	require.NoError(t, err)
+	_, _, err = client.MCCGroup.Edit(ctx, 1, &MCCGroup{MCC: []int64{5411}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"mcc": []interface{}{5411.0}}, body)
+	g, _, err = client.MCCGroup.Cancel(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, RestrictionGroupStatusCanceled, g.GetStatus())
+
+	assert.Equal(t, []string{
+		"POST /mccRestrictionGroups",
+		"GET /mccRestrictionGroups/1",
+		"PUT /mccRestrictionGroups/1",
+		"DELETE /mccRestrictionGroups/1",
+	}, requests)
+}
+
+func TestMerchantIDGroupService(t *testing.T) {
+	var requests []string
+	var body map[string]interface{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body = nil
+		status := RestrictionGroupStatusValidated
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		case http.MethodDelete:
+			status = RestrictionGroupStatusCanceled
+		}
+		fmt.Fprintf(w, `{"merchantIdRestrictionGroups":[{"id":1,"isWhitelist":false,"status":%q}]}`, status)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/merchantIdRestrictionGroups", handler)
+	mux.HandleFunc("/merchantIdRestrictionGroups/1", handler)
+	client, teardown := newTestClient(mux.ServeHTTP)
+	defer teardown()
+	ctx := context.Background()
+
+	group := &MerchantIDGroup{Name: String("shops"), Merchants: []string{"M1", "M2"}}
+	group.SetMode(RestrictionDeny)
+	g, _, err := client.MerchantIDGroup.Create(ctx, group)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), g.GetID())
+	assert.Equal(t, RestrictionDeny, g.Mode())
+	assert.Equal(t, map[string]interface{}{"name": "shops", "isWhitelist": false, "merchants": []interface{}{"M1", "M2"}}, body)
+
+	_, _, err = client.MerchantIDGroup.Get(ctx, 1)
+	require.NoError(t, err)
+	_, _, err = client.MerchantIDGroup.Edit(ctx, 1, &MerchantIDGroup{Merchants: []string{"M1"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"merchants": []interface{}{"M1"}}, body)
+	g, _, err = client.MerchantIDGroup.Cancel(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, RestrictionGroupStatusCanceled, g.GetStatus())
+
+	assert.Equal(t, []string{
+		"POST /merchantIdRestrictionGroups",
+		"GET /merchantIdRestrictionGroups/1",
+		"PUT /merchantIdRestrictionGroups/1",
+		"DELETE /merchantIdRestrictionGroups/1",
+	}, requests)
+}
+
+func TestCountryGroupService(t *testing.T) {
+	var requests []string
+	var body map[string]interface{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body = nil
+		status := RestrictionGroupStatusValidated
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		case http.MethodDelete:
+			status = RestrictionGroupStatusCanceled
+		}
+		fmt.Fprintf(w, `{"countryRestrictionGroups":[{"id":1,"isWhitelist":false,"status":%q}]}`, status)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/countryRestrictionGroups", handler)
+	mux.HandleFunc("/countryRestrictionGroups/1", handler)
+	client, teardown := newTestClient(mux.ServeHTTP)
+	defer teardown()
+	ctx := context.Background()
+
+	group := &CountryGroup{Name: String("europe"), Countries: []string{"250", "276"}}
+	group.SetMode(RestrictionDeny)
+	g, _, err := client.CountryGroup.Create(ctx, group)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), g.GetID())
+	assert.Equal(t, RestrictionDeny, g.Mode())
+	assert.Equal(t, map[string]interface{}{"name": "europe", "isWhitelist": false, "countries": []interface{}{"250", "276"}}, body)
+
+	_, _, err = client.CountryGroup.Get(ctx, 1)
+	require.NoError(t, err)
+	_, _, err = client.CountryGroup.Edit(ctx, 1, &CountryGroup{Countries: []string{"250"}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"countries": []interface{}{"250"}}, body)
+	g, _, err = client.CountryGroup.Cancel(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, RestrictionGroupStatusCanceled, g.GetStatus())
+
+	assert.Equal(t, []string{
+		"POST /countryRestrictionGroups",
+		"GET /countryRestrictionGroups/1",
+		"PUT /countryRestrictionGroups/1",
+		"DELETE /countryRestrictionGroups/1",
+	}, requests)
+}
+
+func TestRestrictionGroup_Mode(t *testing.T) {
+	type group interface {
+		Mode() RestrictionMode
+		SetMode(RestrictionMode)
+	}
+	for _, g := range []group{&MCCGroup{}, &MerchantIDGroup{}, &CountryGroup{}} {
+		t.Run(fmt.Sprintf("%T", g), func(t *testing.T) {
+			assert.Equal(t, RestrictionAllow, g.Mode(), "groups are whitelists by default")
+			for _, m := range []RestrictionMode{RestrictionDeny, RestrictionAllow} {
+				g.SetMode(m)
+				assert.Equal(t, m, g.Mode())
+			}
+		})
+	}
+}
+
+func TestCardService_AttachGroup(t *testing.T) {
+	tests := []struct {
+		name   string
+		attach func(ctx context.Context, s *CardService) (*Card, *http.Response, error)
+		field  string
+	}{
+		{"MCC", func(ctx context.Context, s *CardService) (*Card, *http.Response, error) {
+			return s.AttachMCCGroup(ctx, "1", 42)
+		}, "mccRestrictionGroupId"},
+		{"MerchantID", func(ctx context.Context, s *CardService) (*Card, *http.Response, error) {
+			return s.AttachMerchantIDGroup(ctx, "1", 42)
+		}, "merchantRestrictionGroupId"},
+		{"Country", func(ctx context.Context, s *CardService) (*Card, *http.Response, error) {
+			return s.AttachCountryGroup(ctx, "1", 42)
+		}, "countryRestrictionGroupId"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]interface{}
+			client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/cards/1", r.URL.Path)
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+				w.Write([]byte(`{"cards":[{"cardId":"1"}]}`))
+			})
+			defer teardown()
+
+			card, _, err := tt.attach(context.Background(), client.Card)
+			require.NoError(t, err)
+			assert.Equal(t, "1", card.GetCardID())
+			assert.Equal(t, map[string]interface{}{tt.field: "42"}, body)
+		})
+	}
+}
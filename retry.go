@@ -0,0 +1,205 @@
+package treezor
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultRetryMaxAttempts = 3
+	defaultRetryMinBackoff  = 500 * time.Millisecond
+	defaultRetryMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy configures how Client.Do retries failed requests.
+// Requests are retried on 429 and 5xx responses, on errors whose code is
+// flagged as retryable in the error catalog and on connection errors,
+// waiting an exponential backoff with full jitter between attempts. When the
+// API sends a Retry-After header, it takes precedence over the backoff.
+//
+// Only GET and HEAD requests are replayed unconditionally. Any other request
+// (a POST creating a pay-out, a PUT regenerating a card or changing its PIN, a
+// DELETE) is only replayed when its body carries an Access.IdempotencyKey
+// (accessTag), so that a retry can never apply the same change twice.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Defaults to 3 if zero.
+	MaxAttempts int
+
+	// MinBackoff is the base delay before the first retry.
+	// Defaults to 500ms if zero.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps the delay between two attempts, including delays
+	// requested through Retry-After. Defaults to 30s if zero.
+	MaxBackoff time.Duration
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil {
+		return 1
+	}
+	if p.MaxAttempts <= 0 {
+		return defaultRetryMaxAttempts
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) minBackoff() time.Duration {
+	if p.MinBackoff <= 0 {
+		return defaultRetryMinBackoff
+	}
+	return p.MinBackoff
+}
+
+func (p *RetryPolicy) maxBackoff() time.Duration {
+	if p.MaxBackoff <= 0 {
+		return defaultRetryMaxBackoff
+	}
+	return p.MaxBackoff
+}
+
+// backoff returns the delay to wait before the given retry (1 for the first
+// retry). It honours the Retry-After header of resp when present.
+func (p *RetryPolicy) backoff(retry int, resp *http.Response) time.Duration {
+	max := p.maxBackoff()
+	if d, ok := retryAfter(resp); ok {
+		if d > max {
+			return max
+		}
+		return d
+	}
+
+	d := p.minBackoff()
+	for i := 1; i < retry && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	// Full jitter: pick a random delay in [0, d].
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// retryAfter parses the Retry-After header of resp, which may either be a
+// number of seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// isRetryable reports whether the outcome of an attempt is worth retrying.
+func isRetryable(resp *http.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+	if resp != nil {
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+		var errResp *ErrorResponse
+		return errors.As(err, &errResp) && errResp.Retryable()
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return false
+}
+
+// isReplayable reports whether req can safely be sent again. GET and HEAD
+// requests are; any other request is only replayable when its JSON body
+// carries an accessTag.
+func isReplayable(req *http.Request) bool {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	defer body.Close()
+
+	var access Access
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return false
+	}
+	if err := json.Unmarshal(data, &access); err != nil {
+		return false
+	}
+	return access.GetIdempotencyKey() != ""
+}
+
+// rewindBody resets the body of req so that it can be sent again.
+func rewindBody(req *http.Request) error {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	if req.GetBody == nil {
+		return errors.New("request body cannot be rewound")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Body = body
+	return nil
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
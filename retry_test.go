@@ -0,0 +1,139 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRetryTestClient(t *testing.T, handler http.HandlerFunc) (*Client, func()) {
+	client, teardown := newTestClient(handler)
+	client.Retry = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+	return client, teardown
+}
+
+func TestClient_Do_Retry(t *testing.T) {
+	t.Run("Success after server errors", func(t *testing.T) {
+		var calls int32
+		client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"payouts":[{"payoutId":"1"}]}`))
+		})
+		defer teardown()
+
+		_, _, err := client.Payout.Get(context.Background(), "1")
+		assert.Nil(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+	t.Run("Error after max attempts", func(t *testing.T) {
+		var calls int32
+		client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusTooManyRequests)
+		})
+		defer teardown()
+
+		_, resp, err := client.Payout.Get(context.Background(), "1")
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+	t.Run("Success after retryable error code", func(t *testing.T) {
+		errorCodes[99999] = &ErrorCode{Code: 99999, Retryable: true}
+		defer delete(errorCodes, 99999)
+
+		var calls int32
+		client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 2 {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"errors":[{"errorCode":99999,"errorMessage":"retry"}]}`))
+				return
+			}
+			w.Write([]byte(`{"payouts":[{"payoutId":"1"}]}`))
+		})
+		defer teardown()
+
+		_, _, err := client.Payout.Get(context.Background(), "1")
+		assert.Nil(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+	t.Run("No retry on client errors", func(t *testing.T) {
+		var calls int32
+		client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadRequest)
+		})
+		defer teardown()
+
+		_, _, err := client.Payout.Get(context.Background(), "1")
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+	t.Run("No replay of POST without idempotency key", func(t *testing.T) {
+		var calls int32
+		client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		})
+		defer teardown()
+
+		_, _, err := client.Payout.Create(context.Background(), &Payout{WalletID: String("1"), Currency: EUR})
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+	t.Run("No replay of PUT without idempotency key", func(t *testing.T) {
+		var calls int32
+		client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		})
+		defer teardown()
+
+		_, _, err := client.Card.Regenerate(context.Background(), "1")
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+	t.Run("Replay of POST with idempotency key", func(t *testing.T) {
+		var calls int32
+		client, teardown := newRetryTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 2 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.Write([]byte(`{"transfers":[{"transferId":"1","accessTag":"tag"}]}`))
+		})
+		defer teardown()
+
+		transfer := &Transfer{Access: Access{IdempotencyKey: String("tag")}, WalletID: String("1"), Currency: EUR}
+		tr, _, err := client.Transfer.Create(context.Background(), transfer)
+		assert.Nil(t, err)
+		assert.Equal(t, "1", tr.GetTransferID())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	t.Run("Capped exponential backoff", func(t *testing.T) {
+		p := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 4 * time.Second}
+		for retry := 1; retry < 10; retry++ {
+			assert.True(t, p.backoff(retry, nil) <= 4*time.Second)
+		}
+	})
+	t.Run("Retry-After seconds", func(t *testing.T) {
+		p := &RetryPolicy{MaxBackoff: time.Minute}
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+		assert.Equal(t, 7*time.Second, p.backoff(1, resp))
+	})
+	t.Run("Retry-After capped", func(t *testing.T) {
+		p := &RetryPolicy{MaxBackoff: time.Second}
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+		assert.Equal(t, time.Second, p.backoff(1, resp))
+	})
+}
@@ -0,0 +1,27 @@
+package treezor
+
+// SepaSctrResponse represent data send when a sepa.return_sctr is received
+type SepaSctrResponse struct {
+	SepaSctrs []*SepaSctr `json:"sepaSctrs"`
+}
+
+// SepaSctr represent a returned SEPA credit transfer
+type SepaSctr struct {
+	Access
+	WalletID                  *int64  `json:"wallet_id,omitempty"`
+	VirtualIbanID             *string `json:"virtual_iban_id,omitempty"`
+	TransactionID             *string `json:"transaction_id,omitempty"`
+	ReturnReasonCode          *string `json:"return_reason_code,omitempty"`
+	InterbankSettlementAmount *string `json:"interbank_settlement_amount,omitempty"`
+	SettlementDate            *string `json:"settlement_date,omitempty"`
+	DebitorName               *string `json:"debitor_name,omitempty" treezor:"secret"`
+	DebitorIBAN               *string `json:"debitor_iban,omitempty" treezor:"secret,last4"`
+	DebitorBIC                *string `json:"debitor_bic,omitempty"`
+	CreditorName              *string `json:"creditor_name,omitempty"`
+	CreditorIBAN              *string `json:"creditor_iban,omitempty" treezor:"secret,last4"`
+	CreditorBIC               *string `json:"creditor_bic,omitempty"`
+	EndToEndID                *string `json:"end_to_end_id,omitempty"`
+	UnstructuredField         *string `json:"unstructured_field,omitempty"`
+	PayinID                   *string `json:"payin_id,omitempty"`
+	PayoutID                  *string `json:"payout_id,omitempty"`
+}
@@ -18,8 +18,8 @@ type SepaSddr struct {
 	MandateID                 *string `json:"mandate_id,omitempty"`
 	SepaCreditorIdentifier    *string `json:"sepa_creditor_identifier,omitempty"`
 	DateOfSignature           *string `json:"date_of_signature,omitempty"`
-	DebitorName               *string `json:"debitor_name,omitempty"`
-	DebitorAddress            *string `json:"debitor_address,omitempty"`
+	DebitorName               *string `json:"debitor_name,omitempty" treezor:"secret"`
+	DebitorAddress            *string `json:"debitor_address,omitempty" treezor:"secret"`
 	DebitorCountry            *string `json:"debitor_country,omitempty"`
 	CreditorName              *string `json:"creditor_name,omitempty"`
 	CreditorAddress           *string `json:"creditor_address,omitempty"`
@@ -2,29 +2,44 @@ package treezor
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
-
 	"reflect"
 )
 
 var (
 	timestampParisType  = reflect.TypeOf(TimestampParis{})
 	timestampLondonType = reflect.TypeOf(TimestampLondon{})
+	rawMessageType      = reflect.TypeOf(json.RawMessage{})
+	eventType           = reflect.TypeOf(Event{})
 )
 
 // Stringify attempts to create a reasonable string representation of types in
 // the Treezor library. It does things like resolve pointers to their values
-// and omits struct fields with nil values.
+// and omits struct fields with nil values. The values of the fields tagged
+// with `treezor:"secret"` are masked, as by Redact, and so are those of the
+// payloads of events.
 func Stringify(message interface{}) string {
 	var buf bytes.Buffer
 	v := reflect.ValueOf(message)
-	stringifyValue(&buf, v)
+	stringifyValue(&buf, v, true)
 	return buf.String()
 }
 
-// stringifyValue was heavily inspired by the goprotobuf library.
-func stringifyValue(w io.Writer, val reflect.Value) {
+// StringifyUnsafe is like Stringify but does not mask any field. It is meant
+// for explicit debugging only: its output may contain PINs, IBANs or other
+// personal data, and must not be logged.
+func StringifyUnsafe(message interface{}) string {
+	var buf bytes.Buffer
+	v := reflect.ValueOf(message)
+	stringifyValue(&buf, v, false)
+	return buf.String()
+}
+
+// stringifyValue was heavily inspired by the goprotobuf library. Secret
+// fields are masked if safe is true.
+func stringifyValue(w io.Writer, val reflect.Value, safe bool) {
 	if val.Kind() == reflect.Ptr && val.IsNil() {
 		w.Write([]byte("<nil>"))
 		return
@@ -32,6 +47,17 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 
 	v := reflect.Indirect(val)
 
+	// JSON payloads are printed as is, once masked for events.
+	if v.Type() == rawMessageType {
+		fmt.Fprintf(w, "%s", v.Bytes())
+		return
+	}
+	if safe && v.Type() == eventType {
+		e := v.Interface().(Event)
+		e.RawPayload = e.redactedPayload()
+		v = reflect.ValueOf(e)
+	}
+
 	switch v.Kind() {
 	case reflect.String:
 		fmt.Fprintf(w, `"%s"`, v)
@@ -42,7 +68,7 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 				w.Write([]byte{' '})
 			}
 
-			stringifyValue(w, v.Index(i))
+			stringifyValue(w, v.Index(i), safe)
 		}
 
 		w.Write([]byte{']'})
@@ -62,6 +88,9 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 
 		var sep bool
 		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath != "" {
+				continue // Unexported field.
+			}
 			fv := v.Field(i)
 			if fv.Kind() == reflect.Ptr && fv.IsNil() {
 				continue
@@ -76,9 +105,14 @@ func stringifyValue(w io.Writer, val reflect.Value) {
 				sep = true
 			}
 
-			w.Write([]byte(v.Type().Field(i).Name))
+			f := v.Type().Field(i)
+			w.Write([]byte(f.Name))
 			w.Write([]byte{':'})
-			stringifyValue(w, fv)
+			if mask, ok := fieldMask(f); ok && safe {
+				fmt.Fprintf(w, `"%s"`, maskValue(fv, mask))
+				continue
+			}
+			stringifyValue(w, fv, safe)
 		}
 
 		w.Write([]byte{'}'})
@@ -0,0 +1,49 @@
+package treezor
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringify(t *testing.T) {
+	b := &BeneficiaryRequest{
+		Name: String("Jane Doe"),
+		IBAN: String("FR7630001007941234567890185"),
+		BIC:  String("BNPAFRPP"),
+	}
+	assert.Equal(t, `treezor.BeneficiaryRequest{Access:treezor.Access{}, Name:"REDACTED", IBAN:"****0185", BIC:"BNPAFRPP"}`, Stringify(b))
+	assert.Equal(t, `treezor.BeneficiaryRequest{Access:treezor.Access{}, Name:"Jane Doe", IBAN:"FR7630001007941234567890185", BIC:"BNPAFRPP"}`, StringifyUnsafe(b))
+
+	pin := PIN{Current: "1234", New: "0000", Confirmation: "0000"}
+	assert.Equal(t, `treezor.PIN{Current:"REDACTED", New:"REDACTED", Confirmation:"REDACTED"}`, Stringify(pin))
+
+	t.Run("Event", func(t *testing.T) {
+		payload := json.RawMessage(`{"cards":[{"cardId":"1","pin":"1234"}]}`)
+		evt := Event{Type: String("card.setpin"), RawPayload: &payload}
+		assert.Equal(t, `treezor.Event{Type:"card.setpin", RawPayload:{"cards":[{"cardId":"1","pin":"REDACTED"}]}}`, fmt.Sprint(evt))
+		assert.Equal(t, `treezor.Event{Type:"card.setpin", RawPayload:{"cards":[{"cardId":"1","pin":"1234"}]}}`, StringifyUnsafe(evt))
+		assert.Equal(t, `{"cards":[{"cardId":"1","pin":"1234"}]}`, string(payload), "the event is not modified")
+	})
+	t.Run("Event unknown type", func(t *testing.T) {
+		payload := json.RawMessage(`{"name":"Jane Doe"}`)
+		evt := &Event{Type: String("unknown.event"), RawPayload: &payload}
+		assert.Equal(t, `treezor.Event{Type:"unknown.event", RawPayload:"REDACTED"}`, Stringify(evt))
+	})
+}
+
+func TestRedact_Last4(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{"iban": "****0185"}, Redact(&BeneficiaryRequest{IBAN: String("FR7630001007941234567890185")}))
+	assert.Equal(t, map[string]interface{}{"iban": Redacted}, Redact(&BeneficiaryRequest{IBAN: String("0185")}))
+}
+
+func TestFieldMask(t *testing.T) {
+	type s struct {
+		Plain  string
+		Secret string `treezor:"secret"`
+		Last4  string `treezor:"secret,last4"`
+	}
+	assert.Equal(t, `treezor.s{Plain:"a", Secret:"REDACTED", Last4:"****0185"}`, Stringify(s{"a", "b", "FR7630001007941234567890185"}))
+}
@@ -34,7 +34,7 @@ func (s *TaxResidencesService) Create(ctx context.Context, taxResidence *TaxResi
 	c, _ := s.client.NewRequest(http.MethodPost, "taxResidences", taxResidence)
 
 	t := new(TaxResidencesResponse)
-	resp, err := s.client.Do(ctx, c, t)
+	resp, err := s.client.Do(WithOperation(ctx, "TaxResidencesService.Create"), c, t)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -51,7 +51,7 @@ func (s *TaxResidencesService) Edit(ctx context.Context, taxResidenceID int64, t
 	c, _ := s.client.NewRequest(http.MethodPut, id, taxResidence)
 
 	t := new(TaxResidencesResponse)
-	resp, err := s.client.Do(ctx, c, t)
+	resp, err := s.client.Do(WithOperation(ctx, "TaxResidencesService.Edit"), c, t)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
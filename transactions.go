@@ -0,0 +1,134 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// TransactionService handles communication with the transaction related
+// methods of the Treezor API. Transactions are the unified ledger of the
+// wallet movements made by pay-ins, pay-outs, transfers and card
+// transactions.
+//
+// Treezor API docs: https://www.treezor.com/api-documentation/#/transaction
+type TransactionService service
+
+// Treezor transaction types, telling which operation ForeignID refers to.
+const (
+	TransactionTypePayin           = "Payin"
+	TransactionTypePayout          = "Payout"
+	TransactionTypeTransfer        = "Transfer"
+	TransactionTypeCardTransaction = "Card Transaction"
+)
+
+// TransactionResponse represents a list of wallet transactions.
+// It may contain only one item.
+type TransactionResponse struct {
+	Transactions []*Transaction `json:"transactions"`
+}
+
+// Transaction represents a movement on a wallet, as listed in the unified
+// transaction ledger.
+type Transaction struct {
+	TransactionID       *string         `json:"transactionId,omitempty"`
+	WalletDebitID       *string         `json:"walletDebitId,omitempty"`
+	WalletCreditID      *string         `json:"walletCreditId,omitempty"`
+	TransactionType     *string         `json:"transactionType,omitempty"`
+	ForeignID           *string         `json:"foreignId,omitempty"`
+	Name                *string         `json:"name,omitempty"`
+	Description         *string         `json:"description,omitempty"`
+	ValueDate           *Date           `json:"valueDate,omitempty"`
+	ExecutionDate       *TimestampParis `json:"executionDate,omitempty"`
+	Amount              *float64        `json:"amount,string,omitempty" money:"Currency"`
+	WalletDebitBalance  *float64        `json:"walletDebitBalance,string,omitempty" money:"Currency"`
+	WalletCreditBalance *float64        `json:"walletCreditBalance,string,omitempty" money:"Currency"`
+	Currency            Currency        `json:"currency,omitempty"`
+	CreatedDate         *TimestampParis `json:"createdDate,omitempty"`
+	TotalRows           *int64          `json:"totalRows,string,omitempty"`
+}
+
+// foreignID returns the ForeignID of t if its TransactionType is typ.
+func (t *Transaction) foreignID(typ string) string {
+	if t.GetTransactionType() != typ {
+		return ""
+	}
+	return t.GetForeignID()
+}
+
+// PayinID returns the ID of the pay-in of the transaction, or "" if it was not
+// made by a pay-in.
+func (t *Transaction) PayinID() string {
+	return t.foreignID(TransactionTypePayin)
+}
+
+// PayoutID returns the ID of the pay-out of the transaction, or "" if it was
+// not made by a pay-out.
+func (t *Transaction) PayoutID() string {
+	return t.foreignID(TransactionTypePayout)
+}
+
+// TransferID returns the ID of the transfer of the transaction, or "" if it
+// was not made by a transfer.
+func (t *Transaction) TransferID() string {
+	return t.foreignID(TransactionTypeTransfer)
+}
+
+// CardTransactionID returns the ID of the card transaction of the
+// transaction, or "" if it was not made by a card transaction.
+func (t *Transaction) CardTransactionID() string {
+	return t.foreignID(TransactionTypeCardTransaction)
+}
+
+// Get returns a transaction.
+func (s *TransactionService) Get(ctx context.Context, transactionID string) (*Transaction, *http.Response, error) {
+	u := fmt.Sprintf("transactions/%s", transactionID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(TransactionResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "TransactionService.Get"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.Transactions) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one transaction: %d transactions returned", len(b.Transactions))
+	}
+	return b.Transactions[0], resp, nil
+}
+
+// TransactionListOptions specifies the optional parameters to the TransactionService.List.
+type TransactionListOptions struct {
+	WalletID        string `url:"walletId,omitempty"`
+	TransactionType string `url:"transactionType,omitempty"`
+
+	// Format: YYYY-MM-DD HH:MM:SS
+	ExecutionDateFrom string `url:"executionDateFrom,omitempty"`
+	ExecutionDateTo   string `url:"executionDateTo,omitempty"`
+
+	// Format: YYYY-MM-DD
+	ValueDateFrom string `url:"valueDateFrom,omitempty"`
+	ValueDateTo   string `url:"valueDateTo,omitempty"`
+
+	ListOptions
+}
+
+// List the transactions for the authenticated user.
+func (s *TransactionService) List(ctx context.Context, opt *TransactionListOptions) (*TransactionResponse, *http.Response, error) {
+	u := "transactions"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(TransactionResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "TransactionService.List"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	return b, resp, nil
+}
@@ -0,0 +1,108 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionService_Get(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/transactions/1", r.URL.Path)
+			w.Write([]byte(`{"transactions":[{"transactionId":"1","transactionType":"Payin","foreignId":"7","amount":"12.50","currency":"EUR"}]}`))
+		})
+		defer teardown()
+
+		tx, _, err := client.Transaction.Get(context.Background(), "1")
+		require.NoError(t, err)
+		assert.Equal(t, "1", tx.GetTransactionID())
+		assert.Equal(t, "7", tx.PayinID())
+		assert.Equal(t, NewMoney(1250, EUR), tx.AmountMoney())
+	})
+	t.Run("Error not exactly one transaction", func(t *testing.T) {
+		for _, payload := range []string{`{"transactions":[]}`, `{"transactions":[{"transactionId":"1"},{"transactionId":"2"}]}`} {
+			client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(payload))
+			})
+
+			tx, _, err := client.Transaction.Get(context.Background(), "1")
+			teardown()
+			assert.Nil(t, tx)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "exactly one transaction")
+		}
+	})
+}
+
+func TestTransactionService_List(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/transactions", r.URL.Path)
+		assert.Equal(t, "pageCount=2&pageNumber=3&transactionType=Transfer&valueDateFrom=2020-01-01&walletId=42", r.URL.RawQuery)
+		w.Write([]byte(`{"transactions":[{"transactionId":"1"},{"transactionId":"2"}]}`))
+	})
+	defer teardown()
+
+	r, _, err := client.Transaction.List(context.Background(), &TransactionListOptions{
+		WalletID:        "42",
+		TransactionType: TransactionTypeTransfer,
+		ValueDateFrom:   "2020-01-01",
+		ListOptions:     ListOptions{Page: 3, PerPage: 2},
+	})
+	require.NoError(t, err)
+	assert.Len(t, r.Transactions, 2)
+}
+
+func TestTransactionService_Iterate(t *testing.T) {
+	client, teardown := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "42", r.URL.Query().Get("walletId"))
+		switch page := r.URL.Query().Get("pageNumber"); page {
+		case "1":
+			fmt.Fprint(w, `{"transactions":[{"transactionId":"1","totalRows":"3"},{"transactionId":"2","totalRows":"3"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"transactions":[{"transactionId":"3","totalRows":"3"}]}`)
+		default:
+			t.Errorf("unexpected page %v", page)
+		}
+	})
+	defer teardown()
+
+	var ids []string
+	it := client.Transaction.Iterate(context.Background(), &TransactionListOptions{WalletID: "42"})
+	for it.Next() {
+		ids = append(ids, it.Transaction().GetTransactionID())
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+}
+
+func TestTransaction_ForeignIDs(t *testing.T) {
+	tests := []struct {
+		typ                                              string
+		payinID, payoutID, transferID, cardTransactionID string
+	}{
+		{TransactionTypePayin, "7", "", "", ""},
+		{TransactionTypePayout, "", "7", "", ""},
+		{TransactionTypeTransfer, "", "", "7", ""},
+		{TransactionTypeCardTransaction, "", "", "", "7"},
+		{"Unknown", "", "", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			tx := &Transaction{TransactionType: String(tt.typ), ForeignID: String("7")}
+			assert.Equal(t, tt.payinID, tx.PayinID())
+			assert.Equal(t, tt.payoutID, tx.PayoutID())
+			assert.Equal(t, tt.transferID, tx.TransferID())
+			assert.Equal(t, tt.cardTransactionID, tx.CardTransactionID())
+		})
+	}
+
+	var tx *Transaction
+	assert.Equal(t, "", tx.PayinID(), "nil transaction")
+}
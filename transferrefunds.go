@@ -0,0 +1,149 @@
+package treezor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// TransferRefundService handles communication with the transferrefund related
+// methods of the Treezor API.
+//
+// Treezor API docs: https://www.treezor.com/api-documentation/#/transferrefund
+type TransferRefundService service
+
+// TransferRefundResponse represents a list of transfer refunds.
+// It may contain only one item.
+type TransferRefundResponse struct {
+	TransferRefunds []*TransferRefund `json:"transferrefunds"`
+}
+
+// TransferRefund represents the refund of a transfer.
+type TransferRefund struct {
+	Access
+	TransferRefundID     *string         `json:"transferrefundId,omitempty"`
+	TransferRefundTag    *string         `json:"transferrefundTag,omitempty"`
+	TransferRefundStatus *string         `json:"transferrefundStatus,omitempty"`
+	CodeStatus           *string         `json:"codeStatus,omitempty"`
+	InformationStatus    *string         `json:"informationStatus,omitempty"`
+	WalletID             *string         `json:"walletId,omitempty"`
+	TransferID           *string         `json:"transferId,omitempty"`
+	Amount               *float64        `json:"amount,string,omitempty" money:"Currency,set"`
+	Currency             Currency        `json:"currency,omitempty"`
+	Label                *string         `json:"label,omitempty"`
+	TransferRefundDate   *Date           `json:"transferrefundDate,omitempty"`
+	CreatedDate          *TimestampParis `json:"createdDate,omitempty"`
+	ModifiedDate         *TimestampParis `json:"modifiedDate,omitempty"`
+	TotalRows            *int64          `json:"totalRows,string,omitempty"`
+}
+
+// Create creates a Treezor transfer refund. The required field is TransferID.
+// The transfer and Amount are checked before the refund is created; see refundAmount.
+func (s *TransferRefundService) Create(ctx context.Context, refund *TransferRefund) (*TransferRefund, *http.Response, error) {
+	if refund.GetTransferID() == "" {
+		return nil, nil, errors.New("transfer refund requires a transfer ID")
+	}
+
+	transfer, resp, err := s.client.Transfer.Get(ctx, refund.GetTransferID())
+	if err != nil {
+		return nil, resp, errors.Wrap(err, "getting the refunded transfer")
+	}
+	if err := checkRefundable(transfer.GetTransferStatus()); err != nil {
+		return nil, resp, err
+	}
+	refunds, err := s.ListAll(ctx, &TransferRefundListOptions{TransferID: refund.GetTransferID()})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "listing the refunds of the transfer")
+	}
+	refunded := Money{Currency: transfer.Currency}
+	for _, r := range refunds {
+		if refunded, err = addRefund(refunded, r.GetTransferRefundStatus(), r.AmountMoney()); err != nil {
+			return nil, nil, errors.WithStack(err)
+		}
+	}
+	m, err := refundAmount(refund.Amount, refund.Currency, transfer.AmountMoney(), refunded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := *refund
+	r.SetAmountMoney(m)
+	req, _ := s.client.NewRequest(http.MethodPost, "transferrefunds", &r)
+
+	b := new(TransferRefundResponse)
+	resp, err = s.client.Do(WithOperation(ctx, "TransferRefundService.Create"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.TransferRefunds) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one transfer refund: %d transfer refunds returned", len(b.TransferRefunds))
+	}
+	return b.TransferRefunds[0], resp, nil
+}
+
+// Get returns a transfer refund.
+func (s *TransferRefundService) Get(ctx context.Context, transferRefundID string) (*TransferRefund, *http.Response, error) {
+	u := fmt.Sprintf("transferrefunds/%s", transferRefundID)
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(TransferRefundResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "TransferRefundService.Get"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.TransferRefunds) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one transfer refund: %d transfer refunds returned", len(b.TransferRefunds))
+	}
+	return b.TransferRefunds[0], resp, nil
+}
+
+// TransferRefundListOptions specifies the optional parameters to the TransferRefundService.List.
+type TransferRefundListOptions struct {
+	TransferRefundStatus string `url:"transferrefundStatus,omitempty"`
+	TransferID           string `url:"transferId,omitempty"`
+	WalletID             string `url:"walletId,omitempty"`
+	CreatedDateFrom      string `url:"createdDateFrom,omitempty"`
+	CreatedDateTo        string `url:"createdDateTo,omitempty"`
+
+	ListOptions
+}
+
+// List the transfer refunds for the authenticated user.
+func (s *TransferRefundService) List(ctx context.Context, opt *TransferRefundListOptions) (*TransferRefundResponse, *http.Response, error) {
+	u := "transferrefunds"
+	u, err := addOptions(u, opt)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
+
+	b := new(TransferRefundResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "TransferRefundService.List"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	return b, resp, nil
+}
+
+// Cancel cancels a transfer refund. Its status is changed to CANCELED. A validated
+// transfer refund can't be canceled.
+func (s *TransferRefundService) Cancel(ctx context.Context, transferRefundID string) (*TransferRefund, *http.Response, error) {
+	u := fmt.Sprintf("transferrefunds/%s", transferRefundID)
+	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
+
+	b := new(TransferRefundResponse)
+	resp, err := s.client.Do(WithOperation(ctx, "TransferRefundService.Cancel"), req, b)
+	if err != nil {
+		return nil, resp, errors.WithStack(err)
+	}
+
+	if len(b.TransferRefunds) != 1 {
+		return nil, resp, errors.Errorf("API did not returned exactly one transfer refund: %d transfer refunds returned", len(b.TransferRefunds))
+	}
+	return b.TransferRefunds[0], resp, nil
+}
@@ -48,7 +48,7 @@ type Transfer struct {
 	WalletAlias                *string         `json:"walletAlias,omitempty"`
 	BeneficiaryWalletEventName *string         `json:"beneficiaryWalletEventName,omitempty"`
 	BeneficiaryWalletAlias     *string         `json:"beneficiaryWalletAlias,omitempty"`
-	Amount                     *float64        `json:"amount,string,omitempty"`
+	Amount                     *float64        `json:"amount,string,omitempty" money:"Currency,set"`
 	Currency                   Currency        `json:"currency,omitempty"`
 	Label                      *string         `json:"label,omitempty"`
 	CreatedDate                *TimestampParis `json:"createdDate,omitempty"`
@@ -57,11 +57,20 @@ type Transfer struct {
 }
 
 // Create creates a Treezor transfer. Required: WalletID, BeneficiaryWalletID,Amount,Currency(ISO 4217)
+// It returns ErrInvalidCurrency, without calling the API, if Currency is not a
+// known ISO 4217 code.
 func (s *TransferService) Create(ctx context.Context, transfer *Transfer) (*Transfer, *http.Response, error) {
+	if transfer == nil {
+		return nil, nil, errors.New("missing transfer")
+	}
+	if err := transfer.Currency.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	req, _ := s.client.NewRequest(http.MethodPost, "transfers", transfer)
 
 	b := new(TransferResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "TransferService.Create"), req, b)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -78,7 +87,7 @@ func (s *TransferService) Get(ctx context.Context, transferID string) (*Transfer
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(TransferResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "TransferService.Get"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -89,6 +98,18 @@ func (s *TransferService) Get(ctx context.Context, transferID string) (*Transfer
 	return b.Transfers[0], resp, nil
 }
 
+// GetMany returns the transfers with the given IDs, in the same order,
+// fetching them concurrently. If some of them cannot be fetched, their entries
+// are nil and a *GetManyError holding the error of each ID is returned.
+func (s *TransferService) GetMany(ctx context.Context, transferIDs []string, opt *GetManyOptions) ([]*Transfer, error) {
+	transfers := make([]*Transfer, len(transferIDs))
+	err := getMany(ctx, transferIDs, opt, func(ctx context.Context, i int) (err error) {
+		transfers[i], _, err = s.Get(ctx, transferIDs[i])
+		return err
+	})
+	return transfers, err
+}
+
 // TransferListOptions specifies the optional parameters to the TransferService.List.
 type TransferListOptions struct {
 	UserID              string `url:"userId,omitempty"`
@@ -115,7 +136,7 @@ func (s *TransferService) List(ctx context.Context, opt *TransferListOptions) (*
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	b := new(TransferResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "TransferService.List"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -129,7 +150,7 @@ func (s *TransferService) Delete(ctx context.Context, transferID string) (*Trans
 	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
 
 	b := new(TransferResponse)
-	resp, err := s.client.Do(ctx, req, b)
+	resp, err := s.client.Do(WithOperation(ctx, "TransferService.Delete"), req, b)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
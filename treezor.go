@@ -1,4 +1,7 @@
+//go:generate go run gen_errors.go -v
 //go:generate go run gen_accessors.go -v
+//go:generate go run gen_iterators.go -v
+//go:generate go run gen_mocks.go -v
 
 package treezor
 
@@ -40,6 +43,31 @@ type Client struct {
 	// User agent used when communicating with the Treezor API.
 	UserAgent string
 
+	// Retry configures automatic retries of failed requests. Requests are
+	// attempted only once if nil.
+	Retry *RetryPolicy
+
+	// RateLimiter throttles the requests, retries included. Requests are not
+	// throttled if nil.
+	RateLimiter *RateLimiter
+
+	// CircuitBreaker, if set, fails the requests fast with ErrCircuitOpen
+	// while the API is degraded.
+	CircuitBreaker *CircuitBreaker
+
+	// Logger, if set, records every API call. Request and response bodies
+	// are only recorded if LogBodies is true, once redacted by Redact.
+	Logger    Logger
+	LogBodies bool
+
+	// Tracer, if set, starts a span for every API call, named after the
+	// operation. Meter, if set, records the latency and errors of every API
+	// call. See the treezorotel package for OpenTelemetry adapters.
+	Tracer Tracer
+	Meter  Meter
+
+	middlewares []Middleware // Added with Use.
+
 	common          service // Reuse a single struct instead of allocating one for each service on the heap.
 	User            *UserService
 	Wallet          *WalletService
@@ -51,6 +79,14 @@ type Client struct {
 	Transfer        *TransferService
 	Payin           *PayinService
 	Payout          *PayoutService
+	BankAccount     *BankAccountService
+	Mandate         *MandateService
+	PayinRefund     *PayinRefundService
+	TransferRefund  *TransferRefundService
+	Transaction     *TransactionService
+	MCCGroup        *MCCGroupService
+	MerchantIDGroup *MerchantIDGroupService
+	CountryGroup    *CountryGroupService
 	Hearthbeat      *HearthbeatService
 	TaxResidences   *TaxResidencesService
 }
@@ -68,6 +104,10 @@ type ListOptions struct {
 	// For paginated result sets, the number of results to include per page.
 	PerPage int `url:"pageCount,omitempty"`
 
+	// MaxItems caps the number of results returned by the iterators and
+	// ListAll helpers. It is not sent to the API and is ignored by List.
+	MaxItems int `url:"-"`
+
 	// For paginated result sets, the resource element you want to sort the list with.
 	SortBy string `url:"sortBy,omitempty"`
 
@@ -142,6 +182,14 @@ func NewClient(httpClient *http.Client, isProduction bool) *Client {
 	c.Transfer = (*TransferService)(&c.common)
 	c.Payin = (*PayinService)(&c.common)
 	c.Payout = (*PayoutService)(&c.common)
+	c.BankAccount = (*BankAccountService)(&c.common)
+	c.Mandate = (*MandateService)(&c.common)
+	c.PayinRefund = (*PayinRefundService)(&c.common)
+	c.TransferRefund = (*TransferRefundService)(&c.common)
+	c.Transaction = (*TransactionService)(&c.common)
+	c.MCCGroup = (*MCCGroupService)(&c.common)
+	c.MerchantIDGroup = (*MerchantIDGroupService)(&c.common)
+	c.CountryGroup = (*CountryGroupService)(&c.common)
 	c.Hearthbeat = (*HearthbeatService)(&c.common)
 	c.TaxResidences = (*TaxResidencesService)(&c.common)
 	return c
@@ -179,6 +227,7 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
+		req = withRequestBody(req, body)
 	}
 	req.Header.Set("Accept", "application/json")
 	if c.UserAgent != "" {
@@ -215,6 +264,7 @@ func (c *Client) NewRequestWithoutIndex(method, urlStr string, body interface{})
 
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
+		req = withRequestBody(req, body)
 	}
 	req.Header.Set("Accept", "application/json")
 	if c.UserAgent != "" {
@@ -229,11 +279,75 @@ func (c *Client) NewRequestWithoutIndex(method, urlStr string, body interface{})
 // interface, the raw response body will be written to v, without attempting to
 // first decode it.
 //
+// If the Client has a RetryPolicy, failed attempts are retried according to
+// it before the last error is returned.
+//
+// The request goes through the middlewares added with Use, as the operation
+// named in ctx by WithOperation.
+//
 // The provided ctx must be non-nil. If it is canceled or times out,
 // ctx.Err() will be returned.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
-	req = req.WithContext(ctx)
+	op := &Operation{Name: OperationName(ctx), Request: req, Body: requestBody(req)}
+	return c.handler()(ctx, op, v)
+}
+
+// do is the Handler wrapped by the middlewares.
+func (c *Client) do(ctx context.Context, op *Operation, v interface{}) (*http.Response, error) {
+	req := op.Request.WithContext(ctx)
+
+	var (
+		resp *http.Response
+		err  error
+	)
+	for attempt := 1; ; attempt++ {
+		if lerr := c.RateLimiter.wait(ctx, op); lerr != nil {
+			if attempt == 1 {
+				return nil, lerr
+			}
+			return resp, err // The error of the last attempt is more useful.
+		}
+		op.Attempts = attempt
+		resp, err = c.bareDo(ctx, req)
+		c.RateLimiter.observe(op, resp)
+		if err == nil {
+			break
+		}
+		if attempt >= c.Retry.maxAttempts() || !isRetryable(resp, err) || !isReplayable(req) {
+			return resp, err
+		}
+		if werr := sleepContext(ctx, c.Retry.backoff(attempt, resp)); werr != nil {
+			return resp, err
+		}
+		if rerr := rewindBody(req); rerr != nil {
+			return resp, err
+		}
+	}
+
+	defer func() {
+		// Drain up to 512 bytes and close the body to let the Transport reuse the connection
+		io.CopyN(ioutil.Discard, resp.Body, 512)
+		resp.Body.Close()
+	}()
+
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			io.Copy(w, resp.Body)
+		} else {
+			err = json.NewDecoder(resp.Body).Decode(v)
+			if err == io.EOF {
+				err = nil // ignore EOF errors caused by empty response body
+			}
+		}
+	}
 
+	return resp, errors.WithStack(err)
+}
+
+// bareDo sends a single attempt of req and checks the API response for
+// errors. On success the response body is left open for the caller to
+// consume; on error it has already been closed.
+func (c *Client) bareDo(ctx context.Context, req *http.Request) (*http.Response, error) {
 	resp, err := c.client.Do(req)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
@@ -255,31 +369,16 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*htt
 		return nil, errors.WithStack(err)
 	}
 
-	defer func() {
-		// Drain up to 512 bytes and close the body to let the Transport reuse the connection
-		io.CopyN(ioutil.Discard, resp.Body, 512)
-		resp.Body.Close()
-	}()
-
 	err = CheckResponse(resp)
 	if err != nil {
 		// even though there was an error, we still return the response
 		// in case the caller wants to inspect it further
+		io.CopyN(ioutil.Discard, resp.Body, 512)
+		resp.Body.Close()
 		return resp, err
 	}
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			io.Copy(w, resp.Body)
-		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
-			if err == io.EOF {
-				err = nil // ignore EOF errors caused by empty response body
-			}
-		}
-	}
-
-	return resp, errors.WithStack(err)
+	return resp, nil
 }
 
 // sanitizeURL redacts the client_secret parameter from the URL which may be
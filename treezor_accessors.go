@@ -40,6 +40,14 @@ func (b *Balance) GetAuthorizations() float64 {
 	return 0.0
 }
 
+// AuthorizationsMoney returns the Authorizations field as Money in the currency of Currency.
+func (b *Balance) AuthorizationsMoney() Money {
+	if b == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(b.GetAuthorizations(), b.Currency)
+}
+
 // GetAuthorizedBalance returns the AuthorizedBalance field if it's non-nil, zero value otherwise.
 func (b *Balance) GetAuthorizedBalance() float64 {
 	if b != nil && b.AuthorizedBalance != nil {
@@ -48,6 +56,14 @@ func (b *Balance) GetAuthorizedBalance() float64 {
 	return 0.0
 }
 
+// AuthorizedBalanceMoney returns the AuthorizedBalance field as Money in the currency of Currency.
+func (b *Balance) AuthorizedBalanceMoney() Money {
+	if b == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(b.GetAuthorizedBalance(), b.Currency)
+}
+
 // GetCalculationDate returns the CalculationDate field if it's non-nil, zero value otherwise.
 func (b *Balance) GetCalculationDate() TimestampParis {
 	if b != nil && b.CalculationDate != nil {
@@ -64,6 +80,14 @@ func (b *Balance) GetCurrentBalance() float64 {
 	return 0.0
 }
 
+// CurrentBalanceMoney returns the CurrentBalance field as Money in the currency of Currency.
+func (b *Balance) CurrentBalanceMoney() Money {
+	if b == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(b.GetCurrentBalance(), b.Currency)
+}
+
 // GetWalletID returns the WalletID field if it's non-nil, zero value otherwise.
 func (b *Balance) GetWalletID() string {
 	if b != nil && b.WalletID != nil {
@@ -80,6 +104,126 @@ func (b *BalanceResponse) GetBalances() []*Balance {
 	return nil
 }
 
+// GetBankAccountBIC returns the BankAccountBIC field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetBankAccountBIC() string {
+	if b != nil && b.BankAccountBIC != nil {
+		return *b.BankAccountBIC
+	}
+	return ""
+}
+
+// GetBankAccountIBAN returns the BankAccountIBAN field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetBankAccountIBAN() string {
+	if b != nil && b.BankAccountIBAN != nil {
+		return *b.BankAccountIBAN
+	}
+	return ""
+}
+
+// GetBankAccountID returns the BankAccountID field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetBankAccountID() string {
+	if b != nil && b.BankAccountID != nil {
+		return *b.BankAccountID
+	}
+	return ""
+}
+
+// GetBankAccountOwnerAddress returns the BankAccountOwnerAddress field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetBankAccountOwnerAddress() string {
+	if b != nil && b.BankAccountOwnerAddress != nil {
+		return *b.BankAccountOwnerAddress
+	}
+	return ""
+}
+
+// GetBankAccountOwnerName returns the BankAccountOwnerName field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetBankAccountOwnerName() string {
+	if b != nil && b.BankAccountOwnerName != nil {
+		return *b.BankAccountOwnerName
+	}
+	return ""
+}
+
+// GetBankAccountStatus returns the BankAccountStatus field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetBankAccountStatus() string {
+	if b != nil && b.BankAccountStatus != nil {
+		return *b.BankAccountStatus
+	}
+	return ""
+}
+
+// GetBankAccountTag returns the BankAccountTag field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetBankAccountTag() string {
+	if b != nil && b.BankAccountTag != nil {
+		return *b.BankAccountTag
+	}
+	return ""
+}
+
+// GetBankAccountType returns the BankAccountType field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetBankAccountType() string {
+	if b != nil && b.BankAccountType != nil {
+		return *b.BankAccountType
+	}
+	return ""
+}
+
+// GetCodeStatus returns the CodeStatus field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetCodeStatus() string {
+	if b != nil && b.CodeStatus != nil {
+		return *b.CodeStatus
+	}
+	return ""
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetCreatedDate() TimestampParis {
+	if b != nil && b.CreatedDate != nil {
+		return *b.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetInformationStatus returns the InformationStatus field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetInformationStatus() string {
+	if b != nil && b.InformationStatus != nil {
+		return *b.InformationStatus
+	}
+	return ""
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetModifiedDate() TimestampParis {
+	if b != nil && b.ModifiedDate != nil {
+		return *b.ModifiedDate
+	}
+	return TimestampParis{}
+}
+
+// GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetTotalRows() int64 {
+	if b != nil && b.TotalRows != nil {
+		return *b.TotalRows
+	}
+	return 0
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (b *BankAccount) GetUserID() string {
+	if b != nil && b.UserID != nil {
+		return *b.UserID
+	}
+	return ""
+}
+
+// GetBankAccounts returns the BankAccounts field.
+func (b *BankAccountResponse) GetBankAccounts() []*BankAccount {
+	if b != nil {
+		return b.BankAccounts
+	}
+	return nil
+}
+
 // GetAddress returns the Address field if it's non-nil, zero value otherwise.
 func (b *Beneficiary) GetAddress() string {
 	if b != nil && b.Address != nil {
@@ -336,6 +480,14 @@ func (c *Card) GetCountryCode() string {
 	return ""
 }
 
+// GetCountryRestrictionGroupID returns the CountryRestrictionGroupID field if it's non-nil, zero value otherwise.
+func (c *Card) GetCountryRestrictionGroupID() string {
+	if c != nil && c.CountryRestrictionGroupID != nil {
+		return *c.CountryRestrictionGroupID
+	}
+	return ""
+}
+
 // GetCreatedBy returns the CreatedBy field if it's non-nil, zero value otherwise.
 func (c *Card) GetCreatedBy() string {
 	if c != nil && c.CreatedBy != nil {
@@ -712,6 +864,14 @@ func (c *Card) GetTotalATMAll() float64 {
 	return 0.0
 }
 
+// TotalATMAllMoney returns the TotalATMAll field as Money in the currency of CurrencyCode.
+func (c *Card) TotalATMAllMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalATMAll(), c.CurrencyCode)
+}
+
 // GetTotalATMDay returns the TotalATMDay field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalATMDay() float64 {
 	if c != nil && c.TotalATMDay != nil {
@@ -720,6 +880,14 @@ func (c *Card) GetTotalATMDay() float64 {
 	return 0.0
 }
 
+// TotalATMDayMoney returns the TotalATMDay field as Money in the currency of CurrencyCode.
+func (c *Card) TotalATMDayMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalATMDay(), c.CurrencyCode)
+}
+
 // GetTotalATMMonth returns the TotalATMMonth field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalATMMonth() float64 {
 	if c != nil && c.TotalATMMonth != nil {
@@ -728,6 +896,14 @@ func (c *Card) GetTotalATMMonth() float64 {
 	return 0.0
 }
 
+// TotalATMMonthMoney returns the TotalATMMonth field as Money in the currency of CurrencyCode.
+func (c *Card) TotalATMMonthMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalATMMonth(), c.CurrencyCode)
+}
+
 // GetTotalATMWeek returns the TotalATMWeek field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalATMWeek() float64 {
 	if c != nil && c.TotalATMWeek != nil {
@@ -736,6 +912,14 @@ func (c *Card) GetTotalATMWeek() float64 {
 	return 0.0
 }
 
+// TotalATMWeekMoney returns the TotalATMWeek field as Money in the currency of CurrencyCode.
+func (c *Card) TotalATMWeekMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalATMWeek(), c.CurrencyCode)
+}
+
 // GetTotalATMYear returns the TotalATMYear field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalATMYear() float64 {
 	if c != nil && c.TotalATMYear != nil {
@@ -744,6 +928,14 @@ func (c *Card) GetTotalATMYear() float64 {
 	return 0.0
 }
 
+// TotalATMYearMoney returns the TotalATMYear field as Money in the currency of CurrencyCode.
+func (c *Card) TotalATMYearMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalATMYear(), c.CurrencyCode)
+}
+
 // GetTotalPaymentAll returns the TotalPaymentAll field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalPaymentAll() float64 {
 	if c != nil && c.TotalPaymentAll != nil {
@@ -752,6 +944,14 @@ func (c *Card) GetTotalPaymentAll() float64 {
 	return 0.0
 }
 
+// TotalPaymentAllMoney returns the TotalPaymentAll field as Money in the currency of CurrencyCode.
+func (c *Card) TotalPaymentAllMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalPaymentAll(), c.CurrencyCode)
+}
+
 // GetTotalPaymentDay returns the TotalPaymentDay field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalPaymentDay() float64 {
 	if c != nil && c.TotalPaymentDay != nil {
@@ -760,6 +960,14 @@ func (c *Card) GetTotalPaymentDay() float64 {
 	return 0.0
 }
 
+// TotalPaymentDayMoney returns the TotalPaymentDay field as Money in the currency of CurrencyCode.
+func (c *Card) TotalPaymentDayMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalPaymentDay(), c.CurrencyCode)
+}
+
 // GetTotalPaymentMonth returns the TotalPaymentMonth field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalPaymentMonth() float64 {
 	if c != nil && c.TotalPaymentMonth != nil {
@@ -768,6 +976,14 @@ func (c *Card) GetTotalPaymentMonth() float64 {
 	return 0.0
 }
 
+// TotalPaymentMonthMoney returns the TotalPaymentMonth field as Money in the currency of CurrencyCode.
+func (c *Card) TotalPaymentMonthMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalPaymentMonth(), c.CurrencyCode)
+}
+
 // GetTotalPaymentWeek returns the TotalPaymentWeek field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalPaymentWeek() float64 {
 	if c != nil && c.TotalPaymentWeek != nil {
@@ -776,6 +992,14 @@ func (c *Card) GetTotalPaymentWeek() float64 {
 	return 0.0
 }
 
+// TotalPaymentWeekMoney returns the TotalPaymentWeek field as Money in the currency of CurrencyCode.
+func (c *Card) TotalPaymentWeekMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalPaymentWeek(), c.CurrencyCode)
+}
+
 // GetTotalPaymentYear returns the TotalPaymentYear field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalPaymentYear() float64 {
 	if c != nil && c.TotalPaymentYear != nil {
@@ -784,6 +1008,14 @@ func (c *Card) GetTotalPaymentYear() float64 {
 	return 0.0
 }
 
+// TotalPaymentYearMoney returns the TotalPaymentYear field as Money in the currency of CurrencyCode.
+func (c *Card) TotalPaymentYearMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalPaymentYear(), c.CurrencyCode)
+}
+
 // GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
 func (c *Card) GetTotalRows() int64 {
 	if c != nil && c.TotalRows != nil {
@@ -832,6 +1064,94 @@ func (c *Card3DS) GetCardID() string {
 	return ""
 }
 
+// GetActivationCode returns the ActivationCode field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetActivationCode() string {
+	if c != nil && c.ActivationCode != nil {
+		return *c.ActivationCode
+	}
+	return ""
+}
+
+// GetActivationCodeExpiry returns the ActivationCodeExpiry field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetActivationCodeExpiry() string {
+	if c != nil && c.ActivationCodeExpiry != nil {
+		return *c.ActivationCodeExpiry
+	}
+	return ""
+}
+
+// GetCardID returns the CardID field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetCardID() string {
+	if c != nil && c.CardID != nil {
+		return *c.CardID
+	}
+	return ""
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetCreatedDate() TimestampParis {
+	if c != nil && c.CreatedDate != nil {
+		return *c.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetDeviceType returns the DeviceType field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetDeviceType() string {
+	if c != nil && c.DeviceType != nil {
+		return *c.DeviceType
+	}
+	return ""
+}
+
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetExternalID() string {
+	if c != nil && c.ExternalID != nil {
+		return *c.ExternalID
+	}
+	return ""
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetID() string {
+	if c != nil && c.ID != nil {
+		return *c.ID
+	}
+	return ""
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetModifiedDate() TimestampParis {
+	if c != nil && c.ModifiedDate != nil {
+		return *c.ModifiedDate
+	}
+	return TimestampParis{}
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetStatus() string {
+	if c != nil && c.Status != nil {
+		return *c.Status
+	}
+	return ""
+}
+
+// GetTokenRequestor returns the TokenRequestor field if it's non-nil, zero value otherwise.
+func (c *CardDigitalization) GetTokenRequestor() string {
+	if c != nil && c.TokenRequestor != nil {
+		return *c.TokenRequestor
+	}
+	return ""
+}
+
+// GetCardDigitalizations returns the CardDigitalizations field.
+func (c *CardDigitalizationResponse) GetCardDigitalizations() []*CardDigitalization {
+	if c != nil {
+		return c.CardDigitalizations
+	}
+	return nil
+}
+
 // GetCardID returns the CardID field if it's non-nil, zero value otherwise.
 func (c *CardImage) GetCardID() string {
 	if c != nil && c.CardID != nil {
@@ -920,6 +1240,14 @@ func (c *CardTransaction) GetAuthorizedBalance() float64 {
 	return 0.0
 }
 
+// AuthorizedBalanceMoney returns the AuthorizedBalance field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) AuthorizedBalanceMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetAuthorizedBalance(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetCardID returns the CardID field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetCardID() string {
 	if c != nil && c.CardID != nil {
@@ -944,6 +1272,14 @@ func (c *CardTransaction) GetFees() float64 {
 	return 0.0
 }
 
+// FeesMoney returns the Fees field as Money in the currency of PaymentCurrency.
+func (c *CardTransaction) FeesMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetFees(), toCurrency(c.GetPaymentCurrency()))
+}
+
 // GetIs3DS returns the Is3DS field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetIs3DS() string {
 	if c != nil && c.Is3DS != nil {
@@ -1088,6 +1424,14 @@ func (c *CardTransaction) GetPaymentAmount() float64 {
 	return 0.0
 }
 
+// PaymentAmountMoney returns the PaymentAmount field as Money in the currency of PaymentCurrency.
+func (c *CardTransaction) PaymentAmountMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetPaymentAmount(), toCurrency(c.GetPaymentCurrency()))
+}
+
 // GetPaymentCountry returns the PaymentCountry field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetPaymentCountry() string {
 	if c != nil && c.PaymentCountry != nil {
@@ -1192,6 +1536,14 @@ func (c *CardTransaction) GetTotalLimitATMAll() float64 {
 	return 0.0
 }
 
+// TotalLimitATMAllMoney returns the TotalLimitATMAll field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitATMAllMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitATMAll(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitATMDay returns the TotalLimitATMDay field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitATMDay() float64 {
 	if c != nil && c.TotalLimitATMDay != nil {
@@ -1200,6 +1552,14 @@ func (c *CardTransaction) GetTotalLimitATMDay() float64 {
 	return 0.0
 }
 
+// TotalLimitATMDayMoney returns the TotalLimitATMDay field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitATMDayMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitATMDay(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitATMMonth returns the TotalLimitATMMonth field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitATMMonth() float64 {
 	if c != nil && c.TotalLimitATMMonth != nil {
@@ -1208,6 +1568,14 @@ func (c *CardTransaction) GetTotalLimitATMMonth() float64 {
 	return 0.0
 }
 
+// TotalLimitATMMonthMoney returns the TotalLimitATMMonth field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitATMMonthMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitATMMonth(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitATMWeek returns the TotalLimitATMWeek field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitATMWeek() float64 {
 	if c != nil && c.TotalLimitATMWeek != nil {
@@ -1216,6 +1584,14 @@ func (c *CardTransaction) GetTotalLimitATMWeek() float64 {
 	return 0.0
 }
 
+// TotalLimitATMWeekMoney returns the TotalLimitATMWeek field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitATMWeekMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitATMWeek(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitATMYear returns the TotalLimitATMYear field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitATMYear() float64 {
 	if c != nil && c.TotalLimitATMYear != nil {
@@ -1224,6 +1600,14 @@ func (c *CardTransaction) GetTotalLimitATMYear() float64 {
 	return 0.0
 }
 
+// TotalLimitATMYearMoney returns the TotalLimitATMYear field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitATMYearMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitATMYear(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitPaymentAll returns the TotalLimitPaymentAll field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitPaymentAll() float64 {
 	if c != nil && c.TotalLimitPaymentAll != nil {
@@ -1232,6 +1616,14 @@ func (c *CardTransaction) GetTotalLimitPaymentAll() float64 {
 	return 0.0
 }
 
+// TotalLimitPaymentAllMoney returns the TotalLimitPaymentAll field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitPaymentAllMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitPaymentAll(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitPaymentDay returns the TotalLimitPaymentDay field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitPaymentDay() float64 {
 	if c != nil && c.TotalLimitPaymentDay != nil {
@@ -1240,6 +1632,14 @@ func (c *CardTransaction) GetTotalLimitPaymentDay() float64 {
 	return 0.0
 }
 
+// TotalLimitPaymentDayMoney returns the TotalLimitPaymentDay field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitPaymentDayMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitPaymentDay(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitPaymentMonth returns the TotalLimitPaymentMonth field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitPaymentMonth() float64 {
 	if c != nil && c.TotalLimitPaymentMonth != nil {
@@ -1248,6 +1648,14 @@ func (c *CardTransaction) GetTotalLimitPaymentMonth() float64 {
 	return 0.0
 }
 
+// TotalLimitPaymentMonthMoney returns the TotalLimitPaymentMonth field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitPaymentMonthMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitPaymentMonth(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitPaymentWeek returns the TotalLimitPaymentWeek field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitPaymentWeek() float64 {
 	if c != nil && c.TotalLimitPaymentWeek != nil {
@@ -1256,6 +1664,14 @@ func (c *CardTransaction) GetTotalLimitPaymentWeek() float64 {
 	return 0.0
 }
 
+// TotalLimitPaymentWeekMoney returns the TotalLimitPaymentWeek field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitPaymentWeekMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitPaymentWeek(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetTotalLimitPaymentYear returns the TotalLimitPaymentYear field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetTotalLimitPaymentYear() float64 {
 	if c != nil && c.TotalLimitPaymentYear != nil {
@@ -1264,6 +1680,14 @@ func (c *CardTransaction) GetTotalLimitPaymentYear() float64 {
 	return 0.0
 }
 
+// TotalLimitPaymentYearMoney returns the TotalLimitPaymentYear field as Money in the currency of WalletCurrency.
+func (c *CardTransaction) TotalLimitPaymentYearMoney() Money {
+	if c == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(c.GetTotalLimitPaymentYear(), toCurrency(c.GetWalletCurrency()))
+}
+
 // GetWalletCurrency returns the WalletCurrency field if it's non-nil, zero value otherwise.
 func (c *CardTransaction) GetWalletCurrency() string {
 	if c != nil && c.WalletCurrency != nil {
@@ -1288,57 +1712,129 @@ func (c *CardTransactionResponse) GetCardTransactions() []*CardTransaction {
 	return nil
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (d *Document) GetClientID() string {
-	if d != nil && d.ClientID != nil {
-		return *d.ClientID
+// GetCountries returns the Countries field.
+func (c *CountryGroup) GetCountries() []string {
+	if c != nil {
+		return c.Countries
 	}
-	return ""
+	return nil
 }
 
-// GetCodeStatus returns the CodeStatus field if it's non-nil, zero value otherwise.
-func (d *Document) GetCodeStatus() string {
-	if d != nil && d.CodeStatus != nil {
-		return *d.CodeStatus
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (c *CountryGroup) GetCreatedDate() TimestampParis {
+	if c != nil && c.CreatedDate != nil {
+		return *c.CreatedDate
 	}
-	return ""
+	return TimestampParis{}
 }
 
-// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
-func (d *Document) GetCreatedDate() string {
-	if d != nil && d.CreatedDate != nil {
-		return *d.CreatedDate
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CountryGroup) GetID() int64 {
+	if c != nil && c.ID != nil {
+		return *c.ID
 	}
-	return ""
+	return 0
 }
 
-// GetDocumentID returns the DocumentID field if it's non-nil, zero value otherwise.
-func (d *Document) GetDocumentID() string {
-	if d != nil && d.DocumentID != nil {
-		return *d.DocumentID
+// GetIsWhitelist returns the IsWhitelist field if it's non-nil, zero value otherwise.
+func (c *CountryGroup) GetIsWhitelist() bool {
+	if c != nil && c.IsWhitelist != nil {
+		return *c.IsWhitelist
 	}
-	return ""
+	return false
 }
 
-// GetDocumentStatus returns the DocumentStatus field if it's non-nil, zero value otherwise.
-func (d *Document) GetDocumentStatus() string {
-	if d != nil && d.DocumentStatus != nil {
-		return *d.DocumentStatus
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (c *CountryGroup) GetModifiedDate() TimestampParis {
+	if c != nil && c.ModifiedDate != nil {
+		return *c.ModifiedDate
 	}
-	return ""
+	return TimestampParis{}
 }
 
-// GetDocumentType returns the DocumentType field if it's non-nil, zero value otherwise.
-func (d *Document) GetDocumentType() string {
-	if d != nil && d.DocumentType != nil {
-		return *d.DocumentType
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CountryGroup) GetName() string {
+	if c != nil && c.Name != nil {
+		return *c.Name
 	}
 	return ""
 }
 
-// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
-func (d *Document) GetFilename() string {
-	if d != nil && d.Filename != nil {
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (c *CountryGroup) GetStartDate() TimestampParis {
+	if c != nil && c.StartDate != nil {
+		return *c.StartDate
+	}
+	return TimestampParis{}
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CountryGroup) GetStatus() string {
+	if c != nil && c.Status != nil {
+		return *c.Status
+	}
+	return ""
+}
+
+// GetCountryGroups returns the CountryGroups field.
+func (c *CountryGroupResponse) GetCountryGroups() []*CountryGroup {
+	if c != nil {
+		return c.CountryGroups
+	}
+	return nil
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (d *Document) GetClientID() string {
+	if d != nil && d.ClientID != nil {
+		return *d.ClientID
+	}
+	return ""
+}
+
+// GetCodeStatus returns the CodeStatus field if it's non-nil, zero value otherwise.
+func (d *Document) GetCodeStatus() string {
+	if d != nil && d.CodeStatus != nil {
+		return *d.CodeStatus
+	}
+	return ""
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (d *Document) GetCreatedDate() string {
+	if d != nil && d.CreatedDate != nil {
+		return *d.CreatedDate
+	}
+	return ""
+}
+
+// GetDocumentID returns the DocumentID field if it's non-nil, zero value otherwise.
+func (d *Document) GetDocumentID() string {
+	if d != nil && d.DocumentID != nil {
+		return *d.DocumentID
+	}
+	return ""
+}
+
+// GetDocumentStatus returns the DocumentStatus field if it's non-nil, zero value otherwise.
+func (d *Document) GetDocumentStatus() string {
+	if d != nil && d.DocumentStatus != nil {
+		return *d.DocumentStatus
+	}
+	return ""
+}
+
+// GetDocumentType returns the DocumentType field if it's non-nil, zero value otherwise.
+func (d *Document) GetDocumentType() string {
+	if d != nil && d.DocumentType != nil {
+		return *d.DocumentType
+	}
+	return ""
+}
+
+// GetFilename returns the Filename field if it's non-nil, zero value otherwise.
+func (d *Document) GetFilename() string {
+	if d != nil && d.Filename != nil {
 		return *d.Filename
 	}
 	return ""
@@ -1568,44 +2064,524 @@ func (k *KycLiveness) GetStartedAt() time.Time {
 	return time.Time{}
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (k *KycLiveness) GetUpdatedAt() time.Time {
-	if k != nil && k.UpdatedAt != nil {
-		return *k.UpdatedAt
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (k *KycLiveness) GetUpdatedAt() time.Time {
+	if k != nil && k.UpdatedAt != nil {
+		return *k.UpdatedAt
+	}
+	return time.Time{}
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (k *KycLiveness) GetUserID() string {
+	if k != nil && k.UserID != nil {
+		return *k.UserID
+	}
+	return ""
+}
+
+// GetBirthDate returns the BirthDate field if it's non-nil, zero value otherwise.
+func (k *KycLivenessIdentity) GetBirthDate() string {
+	if k != nil && k.BirthDate != nil {
+		return *k.BirthDate
+	}
+	return ""
+}
+
+// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
+func (k *KycLivenessIdentity) GetFirstName() string {
+	if k != nil && k.FirstName != nil {
+		return *k.FirstName
+	}
+	return ""
+}
+
+// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
+func (k *KycLivenessIdentity) GetLastName() string {
+	if k != nil && k.LastName != nil {
+		return *k.LastName
+	}
+	return ""
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetCreatedDate() TimestampParis {
+	if m != nil && m.CreatedDate != nil {
+		return *m.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetCreatedIP returns the CreatedIP field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetCreatedIP() string {
+	if m != nil && m.CreatedIP != nil {
+		return *m.CreatedIP
+	}
+	return ""
+}
+
+// GetCreditorAddress returns the CreditorAddress field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetCreditorAddress() string {
+	if m != nil && m.CreditorAddress != nil {
+		return *m.CreditorAddress
+	}
+	return ""
+}
+
+// GetCreditorCity returns the CreditorCity field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetCreditorCity() string {
+	if m != nil && m.CreditorCity != nil {
+		return *m.CreditorCity
+	}
+	return ""
+}
+
+// GetCreditorCountry returns the CreditorCountry field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetCreditorCountry() string {
+	if m != nil && m.CreditorCountry != nil {
+		return *m.CreditorCountry
+	}
+	return ""
+}
+
+// GetCreditorName returns the CreditorName field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetCreditorName() string {
+	if m != nil && m.CreditorName != nil {
+		return *m.CreditorName
+	}
+	return ""
+}
+
+// GetCreditorZipCode returns the CreditorZipCode field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetCreditorZipCode() string {
+	if m != nil && m.CreditorZipCode != nil {
+		return *m.CreditorZipCode
+	}
+	return ""
+}
+
+// GetDebtorAddress returns the DebtorAddress field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetDebtorAddress() string {
+	if m != nil && m.DebtorAddress != nil {
+		return *m.DebtorAddress
+	}
+	return ""
+}
+
+// GetDebtorBIC returns the DebtorBIC field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetDebtorBIC() string {
+	if m != nil && m.DebtorBIC != nil {
+		return *m.DebtorBIC
+	}
+	return ""
+}
+
+// GetDebtorCity returns the DebtorCity field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetDebtorCity() string {
+	if m != nil && m.DebtorCity != nil {
+		return *m.DebtorCity
+	}
+	return ""
+}
+
+// GetDebtorCountry returns the DebtorCountry field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetDebtorCountry() string {
+	if m != nil && m.DebtorCountry != nil {
+		return *m.DebtorCountry
+	}
+	return ""
+}
+
+// GetDebtorIBAN returns the DebtorIBAN field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetDebtorIBAN() string {
+	if m != nil && m.DebtorIBAN != nil {
+		return *m.DebtorIBAN
+	}
+	return ""
+}
+
+// GetDebtorName returns the DebtorName field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetDebtorName() string {
+	if m != nil && m.DebtorName != nil {
+		return *m.DebtorName
+	}
+	return ""
+}
+
+// GetDebtorSignatureIP returns the DebtorSignatureIP field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetDebtorSignatureIP() string {
+	if m != nil && m.DebtorSignatureIP != nil {
+		return *m.DebtorSignatureIP
+	}
+	return ""
+}
+
+// GetDebtorZipCode returns the DebtorZipCode field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetDebtorZipCode() string {
+	if m != nil && m.DebtorZipCode != nil {
+		return *m.DebtorZipCode
+	}
+	return ""
+}
+
+// GetLegalInformations returns the LegalInformations field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetLegalInformations() string {
+	if m != nil && m.LegalInformations != nil {
+		return *m.LegalInformations
+	}
+	return ""
+}
+
+// GetMandateID returns the MandateID field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetMandateID() string {
+	if m != nil && m.MandateID != nil {
+		return *m.MandateID
+	}
+	return ""
+}
+
+// GetMandateStatus returns the MandateStatus field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetMandateStatus() string {
+	if m != nil && m.MandateStatus != nil {
+		return *m.MandateStatus
+	}
+	return ""
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetModifiedDate() TimestampParis {
+	if m != nil && m.ModifiedDate != nil {
+		return *m.ModifiedDate
+	}
+	return TimestampParis{}
+}
+
+// GetRevocationSignatureDate returns the RevocationSignatureDate field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetRevocationSignatureDate() Date {
+	if m != nil && m.RevocationSignatureDate != nil {
+		return *m.RevocationSignatureDate
+	}
+	return Date{}
+}
+
+// GetSEPACreditorIdentifier returns the SEPACreditorIdentifier field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetSEPACreditorIdentifier() string {
+	if m != nil && m.SEPACreditorIdentifier != nil {
+		return *m.SEPACreditorIdentifier
+	}
+	return ""
+}
+
+// GetSequenceType returns the SequenceType field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetSequenceType() string {
+	if m != nil && m.SequenceType != nil {
+		return *m.SequenceType
+	}
+	return ""
+}
+
+// GetSignatureDate returns the SignatureDate field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetSignatureDate() Date {
+	if m != nil && m.SignatureDate != nil {
+		return *m.SignatureDate
+	}
+	return Date{}
+}
+
+// GetSigned returns the Signed field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetSigned() int64 {
+	if m != nil && m.Signed != nil {
+		return *m.Signed
+	}
+	return 0
+}
+
+// GetTitle returns the Title field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetTitle() string {
+	if m != nil && m.Title != nil {
+		return *m.Title
+	}
+	return ""
+}
+
+// GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetTotalRows() int64 {
+	if m != nil && m.TotalRows != nil {
+		return *m.TotalRows
+	}
+	return 0
+}
+
+// GetUniqueMandateReference returns the UniqueMandateReference field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetUniqueMandateReference() string {
+	if m != nil && m.UniqueMandateReference != nil {
+		return *m.UniqueMandateReference
+	}
+	return ""
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetUserID() string {
+	if m != nil && m.UserID != nil {
+		return *m.UserID
+	}
+	return ""
+}
+
+// GetUserIDUltimateCreditor returns the UserIDUltimateCreditor field if it's non-nil, zero value otherwise.
+func (m *Mandate) GetUserIDUltimateCreditor() string {
+	if m != nil && m.UserIDUltimateCreditor != nil {
+		return *m.UserIDUltimateCreditor
+	}
+	return ""
+}
+
+// GetMandates returns the Mandates field.
+func (m *MandateResponse) GetMandates() []*Mandate {
+	if m != nil {
+		return m.Mandates
+	}
+	return nil
+}
+
+// GetDebtorSignatureIP returns the DebtorSignatureIP field if it's non-nil, zero value otherwise.
+func (m *MandateSignature) GetDebtorSignatureIP() string {
+	if m != nil && m.DebtorSignatureIP != nil {
+		return *m.DebtorSignatureIP
+	}
+	return ""
+}
+
+// GetOTP returns the OTP field if it's non-nil, zero value otherwise.
+func (m *MandateSignature) GetOTP() string {
+	if m != nil && m.OTP != nil {
+		return *m.OTP
+	}
+	return ""
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (m *MCCGroup) GetCreatedDate() TimestampParis {
+	if m != nil && m.CreatedDate != nil {
+		return *m.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MCCGroup) GetID() int64 {
+	if m != nil && m.ID != nil {
+		return *m.ID
+	}
+	return 0
+}
+
+// GetIsWhitelist returns the IsWhitelist field if it's non-nil, zero value otherwise.
+func (m *MCCGroup) GetIsWhitelist() bool {
+	if m != nil && m.IsWhitelist != nil {
+		return *m.IsWhitelist
+	}
+	return false
+}
+
+// GetMCC returns the MCC field.
+func (m *MCCGroup) GetMCC() []int64 {
+	if m != nil {
+		return m.MCC
+	}
+	return nil
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (m *MCCGroup) GetModifiedDate() TimestampParis {
+	if m != nil && m.ModifiedDate != nil {
+		return *m.ModifiedDate
+	}
+	return TimestampParis{}
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *MCCGroup) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (m *MCCGroup) GetStartDate() TimestampParis {
+	if m != nil && m.StartDate != nil {
+		return *m.StartDate
+	}
+	return TimestampParis{}
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (m *MCCGroup) GetStatus() string {
+	if m != nil && m.Status != nil {
+		return *m.Status
+	}
+	return ""
+}
+
+// GetMCCGroups returns the MCCGroups field.
+func (m *MCCGroupResponse) GetMCCGroups() []*MCCGroup {
+	if m != nil {
+		return m.MCCGroups
+	}
+	return nil
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (m *MerchantIDGroup) GetCreatedDate() TimestampParis {
+	if m != nil && m.CreatedDate != nil {
+		return *m.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (m *MerchantIDGroup) GetID() int64 {
+	if m != nil && m.ID != nil {
+		return *m.ID
+	}
+	return 0
+}
+
+// GetIsWhitelist returns the IsWhitelist field if it's non-nil, zero value otherwise.
+func (m *MerchantIDGroup) GetIsWhitelist() bool {
+	if m != nil && m.IsWhitelist != nil {
+		return *m.IsWhitelist
+	}
+	return false
+}
+
+// GetMerchants returns the Merchants field.
+func (m *MerchantIDGroup) GetMerchants() []string {
+	if m != nil {
+		return m.Merchants
+	}
+	return nil
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (m *MerchantIDGroup) GetModifiedDate() TimestampParis {
+	if m != nil && m.ModifiedDate != nil {
+		return *m.ModifiedDate
+	}
+	return TimestampParis{}
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (m *MerchantIDGroup) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+// GetStartDate returns the StartDate field if it's non-nil, zero value otherwise.
+func (m *MerchantIDGroup) GetStartDate() TimestampParis {
+	if m != nil && m.StartDate != nil {
+		return *m.StartDate
+	}
+	return TimestampParis{}
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (m *MerchantIDGroup) GetStatus() string {
+	if m != nil && m.Status != nil {
+		return *m.Status
+	}
+	return ""
+}
+
+// GetMerchantIDGroups returns the MerchantIDGroups field.
+func (m *MerchantIDGroupResponse) GetMerchantIDGroups() []*MerchantIDGroup {
+	if m != nil {
+		return m.MerchantIDGroups
+	}
+	return nil
+}
+
+// GetCardBrand returns the CardBrand field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetCardBrand() string {
+	if o != nil && o.CardBrand != nil {
+		return *o.CardBrand
+	}
+	return ""
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetCreatedDate() TimestampParis {
+	if o != nil && o.CreatedDate != nil {
+		return *o.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetExpiryDate returns the ExpiryDate field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetExpiryDate() string {
+	if o != nil && o.ExpiryDate != nil {
+		return *o.ExpiryDate
+	}
+	return ""
+}
+
+// GetMaskedPan returns the MaskedPan field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetMaskedPan() string {
+	if o != nil && o.MaskedPan != nil {
+		return *o.MaskedPan
+	}
+	return ""
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetModifiedDate() TimestampParis {
+	if o != nil && o.ModifiedDate != nil {
+		return *o.ModifiedDate
+	}
+	return TimestampParis{}
+}
+
+// GetOneClickCardID returns the OneClickCardID field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetOneClickCardID() string {
+	if o != nil && o.OneClickCardID != nil {
+		return *o.OneClickCardID
 	}
-	return time.Time{}
+	return ""
 }
 
-// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
-func (k *KycLiveness) GetUserID() string {
-	if k != nil && k.UserID != nil {
-		return *k.UserID
+// GetOneClickCardStatus returns the OneClickCardStatus field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetOneClickCardStatus() string {
+	if o != nil && o.OneClickCardStatus != nil {
+		return *o.OneClickCardStatus
 	}
 	return ""
 }
 
-// GetBirthDate returns the BirthDate field if it's non-nil, zero value otherwise.
-func (k *KycLivenessIdentity) GetBirthDate() string {
-	if k != nil && k.BirthDate != nil {
-		return *k.BirthDate
+// GetOneClickCardTag returns the OneClickCardTag field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetOneClickCardTag() string {
+	if o != nil && o.OneClickCardTag != nil {
+		return *o.OneClickCardTag
 	}
 	return ""
 }
 
-// GetFirstName returns the FirstName field if it's non-nil, zero value otherwise.
-func (k *KycLivenessIdentity) GetFirstName() string {
-	if k != nil && k.FirstName != nil {
-		return *k.FirstName
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (o *OneClickCard) GetUserID() string {
+	if o != nil && o.UserID != nil {
+		return *o.UserID
 	}
 	return ""
 }
 
-// GetLastName returns the LastName field if it's non-nil, zero value otherwise.
-func (k *KycLivenessIdentity) GetLastName() string {
-	if k != nil && k.LastName != nil {
-		return *k.LastName
+// GetOneClickCards returns the OneClickCards field.
+func (o *OneClickCardResponse) GetOneClickCards() []*OneClickCard {
+	if o != nil {
+		return o.OneClickCards
 	}
-	return ""
+	return nil
 }
 
 // GetAdditionalData returns the AdditionalData field if it's non-nil, zero value otherwise.
@@ -1624,6 +2600,20 @@ func (p *Payin) GetAmount() float64 {
 	return 0.0
 }
 
+// AmountMoney returns the Amount field as Money in the currency of Currency.
+func (p *Payin) AmountMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetAmount(), p.Currency)
+}
+
+// SetAmountMoney sets the Amount and Currency fields from m.
+func (p *Payin) SetAmountMoney(m Money) {
+	p.Amount = Float64(m.Float64())
+	p.Currency = m.Currency
+}
+
 // GetCodeStatus returns the CodeStatus field if it's non-nil, zero value otherwise.
 func (p *Payin) GetCodeStatus() string {
 	if p != nil && p.CodeStatus != nil {
@@ -1704,6 +2694,14 @@ func (p *Payin) GetDistributorFee() float64 {
 	return 0.0
 }
 
+// DistributorFeeMoney returns the DistributorFee field as Money in the currency of Currency.
+func (p *Payin) DistributorFeeMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetDistributorFee(), p.Currency)
+}
+
 // GetForwardURL returns the ForwardURL field if it's non-nil, zero value otherwise.
 func (p *Payin) GetForwardURL() string {
 	if p != nil && p.ForwardURL != nil {
@@ -1896,6 +2894,14 @@ func (p *Payin) GetRefundAmount() float64 {
 	return 0.0
 }
 
+// RefundAmountMoney returns the RefundAmount field as Money in the currency of Currency.
+func (p *Payin) RefundAmountMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetRefundAmount(), p.Currency)
+}
+
 // GetSubtotalItems returns the SubtotalItems field if it's non-nil, zero value otherwise.
 func (p *Payin) GetSubtotalItems() float64 {
 	if p != nil && p.SubtotalItems != nil {
@@ -1904,6 +2910,14 @@ func (p *Payin) GetSubtotalItems() float64 {
 	return 0.0
 }
 
+// SubtotalItemsMoney returns the SubtotalItems field as Money in the currency of Currency.
+func (p *Payin) SubtotalItemsMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetSubtotalItems(), p.Currency)
+}
+
 // GetSubtotalServices returns the SubtotalServices field if it's non-nil, zero value otherwise.
 func (p *Payin) GetSubtotalServices() float64 {
 	if p != nil && p.SubtotalServices != nil {
@@ -1912,6 +2926,14 @@ func (p *Payin) GetSubtotalServices() float64 {
 	return 0.0
 }
 
+// SubtotalServicesMoney returns the SubtotalServices field as Money in the currency of Currency.
+func (p *Payin) SubtotalServicesMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetSubtotalServices(), p.Currency)
+}
+
 // GetSubtotalTax returns the SubtotalTax field if it's non-nil, zero value otherwise.
 func (p *Payin) GetSubtotalTax() float64 {
 	if p != nil && p.SubtotalTax != nil {
@@ -1920,6 +2942,14 @@ func (p *Payin) GetSubtotalTax() float64 {
 	return 0.0
 }
 
+// SubtotalTaxMoney returns the SubtotalTax field as Money in the currency of Currency.
+func (p *Payin) SubtotalTaxMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetSubtotalTax(), p.Currency)
+}
+
 // GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
 func (p *Payin) GetTotalRows() int64 {
 	if p != nil && p.TotalRows != nil {
@@ -1992,6 +3022,124 @@ func (p *Payin) GetWalletID() string {
 	return ""
 }
 
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetAmount() float64 {
+	if p != nil && p.Amount != nil {
+		return *p.Amount
+	}
+	return 0.0
+}
+
+// AmountMoney returns the Amount field as Money in the currency of Currency.
+func (p *PayinRefund) AmountMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetAmount(), p.Currency)
+}
+
+// SetAmountMoney sets the Amount and Currency fields from m.
+func (p *PayinRefund) SetAmountMoney(m Money) {
+	p.Amount = Float64(m.Float64())
+	p.Currency = m.Currency
+}
+
+// GetCodeStatus returns the CodeStatus field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetCodeStatus() string {
+	if p != nil && p.CodeStatus != nil {
+		return *p.CodeStatus
+	}
+	return ""
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetCreatedDate() TimestampParis {
+	if p != nil && p.CreatedDate != nil {
+		return *p.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetInformationStatus returns the InformationStatus field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetInformationStatus() string {
+	if p != nil && p.InformationStatus != nil {
+		return *p.InformationStatus
+	}
+	return ""
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetModifiedDate() TimestampParis {
+	if p != nil && p.ModifiedDate != nil {
+		return *p.ModifiedDate
+	}
+	return TimestampParis{}
+}
+
+// GetPayinID returns the PayinID field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetPayinID() string {
+	if p != nil && p.PayinID != nil {
+		return *p.PayinID
+	}
+	return ""
+}
+
+// GetPayinRefundDate returns the PayinRefundDate field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetPayinRefundDate() Date {
+	if p != nil && p.PayinRefundDate != nil {
+		return *p.PayinRefundDate
+	}
+	return Date{}
+}
+
+// GetPayinRefundID returns the PayinRefundID field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetPayinRefundID() string {
+	if p != nil && p.PayinRefundID != nil {
+		return *p.PayinRefundID
+	}
+	return ""
+}
+
+// GetPayinRefundStatus returns the PayinRefundStatus field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetPayinRefundStatus() string {
+	if p != nil && p.PayinRefundStatus != nil {
+		return *p.PayinRefundStatus
+	}
+	return ""
+}
+
+// GetPayinRefundTag returns the PayinRefundTag field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetPayinRefundTag() string {
+	if p != nil && p.PayinRefundTag != nil {
+		return *p.PayinRefundTag
+	}
+	return ""
+}
+
+// GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetTotalRows() int64 {
+	if p != nil && p.TotalRows != nil {
+		return *p.TotalRows
+	}
+	return 0
+}
+
+// GetWalletID returns the WalletID field if it's non-nil, zero value otherwise.
+func (p *PayinRefund) GetWalletID() string {
+	if p != nil && p.WalletID != nil {
+		return *p.WalletID
+	}
+	return ""
+}
+
+// GetPayinRefunds returns the PayinRefunds field.
+func (p *PayinRefundResponse) GetPayinRefunds() []*PayinRefund {
+	if p != nil {
+		return p.PayinRefunds
+	}
+	return nil
+}
+
 // GetPayins returns the Payins field.
 func (p *PayinResponse) GetPayins() []*Payin {
 	if p != nil {
@@ -2008,6 +3156,20 @@ func (p *Payout) GetAmount() float64 {
 	return 0.0
 }
 
+// AmountMoney returns the Amount field as Money in the currency of Currency.
+func (p *Payout) AmountMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetAmount(), p.Currency)
+}
+
+// SetAmountMoney sets the Amount and Currency fields from m.
+func (p *Payout) SetAmountMoney(m Money) {
+	p.Amount = Float64(m.Float64())
+	p.Currency = m.Currency
+}
+
 // GetBeneficiaryID returns the BeneficiaryID field if it's non-nil, zero value otherwise.
 func (p *Payout) GetBeneficiaryID() string {
 	if p != nil && p.BeneficiaryID != nil {
@@ -2048,6 +3210,14 @@ func (p *Payout) GetPartnerFee() float64 {
 	return 0.0
 }
 
+// PartnerFeeMoney returns the PartnerFee field as Money in the currency of Currency.
+func (p *Payout) PartnerFeeMoney() Money {
+	if p == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(p.GetPartnerFee(), p.Currency)
+}
+
 // GetPayoutDate returns the PayoutDate field if it's non-nil, zero value otherwise.
 func (p *Payout) GetPayoutDate() Date {
 	if p != nil && p.PayoutDate != nil {
@@ -2096,100 +3266,236 @@ func (p *Payout) GetPayoutTypeID() string {
 	return ""
 }
 
-// GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
-func (p *Payout) GetTotalRows() int64 {
-	if p != nil && p.TotalRows != nil {
-		return *p.TotalRows
+// GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
+func (p *Payout) GetTotalRows() int64 {
+	if p != nil && p.TotalRows != nil {
+		return *p.TotalRows
+	}
+	return 0
+}
+
+// GetUniqueMandateReference returns the UniqueMandateReference field if it's non-nil, zero value otherwise.
+func (p *Payout) GetUniqueMandateReference() string {
+	if p != nil && p.UniqueMandateReference != nil {
+		return *p.UniqueMandateReference
+	}
+	return ""
+}
+
+// GetUserFirstname returns the UserFirstname field if it's non-nil, zero value otherwise.
+func (p *Payout) GetUserFirstname() string {
+	if p != nil && p.UserFirstname != nil {
+		return *p.UserFirstname
+	}
+	return ""
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (p *Payout) GetUserID() string {
+	if p != nil && p.UserID != nil {
+		return *p.UserID
+	}
+	return ""
+}
+
+// GetUserLastname returns the UserLastname field if it's non-nil, zero value otherwise.
+func (p *Payout) GetUserLastname() string {
+	if p != nil && p.UserLastname != nil {
+		return *p.UserLastname
+	}
+	return ""
+}
+
+// GetWalletAlias returns the WalletAlias field if it's non-nil, zero value otherwise.
+func (p *Payout) GetWalletAlias() string {
+	if p != nil && p.WalletAlias != nil {
+		return *p.WalletAlias
+	}
+	return ""
+}
+
+// GetWalletEventName returns the WalletEventName field if it's non-nil, zero value otherwise.
+func (p *Payout) GetWalletEventName() string {
+	if p != nil && p.WalletEventName != nil {
+		return *p.WalletEventName
+	}
+	return ""
+}
+
+// GetWalletID returns the WalletID field if it's non-nil, zero value otherwise.
+func (p *Payout) GetWalletID() string {
+	if p != nil && p.WalletID != nil {
+		return *p.WalletID
+	}
+	return ""
+}
+
+// GetPayouts returns the Payouts field.
+func (p *PayoutResponse) GetPayouts() []*Payout {
+	if p != nil {
+		return p.Payouts
+	}
+	return nil
+}
+
+// GetBeneficiaryID returns the BeneficiaryID field if it's non-nil, zero value otherwise.
+func (s *SDDB2BWhitelist) GetBeneficiaryID() string {
+	if s != nil && s.BeneficiaryID != nil {
+		return *s.BeneficiaryID
+	}
+	return ""
+}
+
+// GetIsRecurrent returns the IsRecurrent field if it's non-nil, zero value otherwise.
+func (s *SDDB2BWhitelist) GetIsRecurrent() bool {
+	if s != nil && s.IsRecurrent != nil {
+		return *s.IsRecurrent
+	}
+	return false
+}
+
+// GetUniqueMandateReference returns the UniqueMandateReference field if it's non-nil, zero value otherwise.
+func (s *SDDB2BWhitelist) GetUniqueMandateReference() string {
+	if s != nil && s.UniqueMandateReference != nil {
+		return *s.UniqueMandateReference
+	}
+	return ""
+}
+
+// GetCreditorBIC returns the CreditorBIC field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetCreditorBIC() string {
+	if s != nil && s.CreditorBIC != nil {
+		return *s.CreditorBIC
+	}
+	return ""
+}
+
+// GetCreditorIBAN returns the CreditorIBAN field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetCreditorIBAN() string {
+	if s != nil && s.CreditorIBAN != nil {
+		return *s.CreditorIBAN
+	}
+	return ""
+}
+
+// GetCreditorName returns the CreditorName field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetCreditorName() string {
+	if s != nil && s.CreditorName != nil {
+		return *s.CreditorName
+	}
+	return ""
+}
+
+// GetDebitorBIC returns the DebitorBIC field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetDebitorBIC() string {
+	if s != nil && s.DebitorBIC != nil {
+		return *s.DebitorBIC
+	}
+	return ""
+}
+
+// GetDebitorIBAN returns the DebitorIBAN field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetDebitorIBAN() string {
+	if s != nil && s.DebitorIBAN != nil {
+		return *s.DebitorIBAN
+	}
+	return ""
+}
+
+// GetDebitorName returns the DebitorName field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetDebitorName() string {
+	if s != nil && s.DebitorName != nil {
+		return *s.DebitorName
 	}
-	return 0
+	return ""
 }
 
-// GetUniqueMandateReference returns the UniqueMandateReference field if it's non-nil, zero value otherwise.
-func (p *Payout) GetUniqueMandateReference() string {
-	if p != nil && p.UniqueMandateReference != nil {
-		return *p.UniqueMandateReference
+// GetEndToEndID returns the EndToEndID field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetEndToEndID() string {
+	if s != nil && s.EndToEndID != nil {
+		return *s.EndToEndID
 	}
 	return ""
 }
 
-// GetUserFirstname returns the UserFirstname field if it's non-nil, zero value otherwise.
-func (p *Payout) GetUserFirstname() string {
-	if p != nil && p.UserFirstname != nil {
-		return *p.UserFirstname
+// GetInterbankSettlementAmount returns the InterbankSettlementAmount field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetInterbankSettlementAmount() string {
+	if s != nil && s.InterbankSettlementAmount != nil {
+		return *s.InterbankSettlementAmount
 	}
 	return ""
 }
 
-// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
-func (p *Payout) GetUserID() string {
-	if p != nil && p.UserID != nil {
-		return *p.UserID
+// GetPayinID returns the PayinID field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetPayinID() string {
+	if s != nil && s.PayinID != nil {
+		return *s.PayinID
 	}
 	return ""
 }
 
-// GetUserLastname returns the UserLastname field if it's non-nil, zero value otherwise.
-func (p *Payout) GetUserLastname() string {
-	if p != nil && p.UserLastname != nil {
-		return *p.UserLastname
+// GetPayoutID returns the PayoutID field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetPayoutID() string {
+	if s != nil && s.PayoutID != nil {
+		return *s.PayoutID
 	}
 	return ""
 }
 
-// GetWalletAlias returns the WalletAlias field if it's non-nil, zero value otherwise.
-func (p *Payout) GetWalletAlias() string {
-	if p != nil && p.WalletAlias != nil {
-		return *p.WalletAlias
+// GetReturnReasonCode returns the ReturnReasonCode field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetReturnReasonCode() string {
+	if s != nil && s.ReturnReasonCode != nil {
+		return *s.ReturnReasonCode
 	}
 	return ""
 }
 
-// GetWalletEventName returns the WalletEventName field if it's non-nil, zero value otherwise.
-func (p *Payout) GetWalletEventName() string {
-	if p != nil && p.WalletEventName != nil {
-		return *p.WalletEventName
+// GetSettlementDate returns the SettlementDate field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetSettlementDate() string {
+	if s != nil && s.SettlementDate != nil {
+		return *s.SettlementDate
 	}
 	return ""
 }
 
-// GetWalletID returns the WalletID field if it's non-nil, zero value otherwise.
-func (p *Payout) GetWalletID() string {
-	if p != nil && p.WalletID != nil {
-		return *p.WalletID
+// GetTransactionID returns the TransactionID field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetTransactionID() string {
+	if s != nil && s.TransactionID != nil {
+		return *s.TransactionID
 	}
 	return ""
 }
 
-// GetPayouts returns the Payouts field.
-func (p *PayoutResponse) GetPayouts() []*Payout {
-	if p != nil {
-		return p.Payouts
+// GetUnstructuredField returns the UnstructuredField field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetUnstructuredField() string {
+	if s != nil && s.UnstructuredField != nil {
+		return *s.UnstructuredField
 	}
-	return nil
+	return ""
 }
 
-// GetBeneficiaryID returns the BeneficiaryID field if it's non-nil, zero value otherwise.
-func (s *SDDB2BWhitelist) GetBeneficiaryID() string {
-	if s != nil && s.BeneficiaryID != nil {
-		return *s.BeneficiaryID
+// GetVirtualIbanID returns the VirtualIbanID field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetVirtualIbanID() string {
+	if s != nil && s.VirtualIbanID != nil {
+		return *s.VirtualIbanID
 	}
 	return ""
 }
 
-// GetIsRecurrent returns the IsRecurrent field if it's non-nil, zero value otherwise.
-func (s *SDDB2BWhitelist) GetIsRecurrent() bool {
-	if s != nil && s.IsRecurrent != nil {
-		return *s.IsRecurrent
+// GetWalletID returns the WalletID field if it's non-nil, zero value otherwise.
+func (s *SepaSctr) GetWalletID() int64 {
+	if s != nil && s.WalletID != nil {
+		return *s.WalletID
 	}
-	return false
+	return 0
 }
 
-// GetUniqueMandateReference returns the UniqueMandateReference field if it's non-nil, zero value otherwise.
-func (s *SDDB2BWhitelist) GetUniqueMandateReference() string {
-	if s != nil && s.UniqueMandateReference != nil {
-		return *s.UniqueMandateReference
+// GetSepaSctrs returns the SepaSctrs field.
+func (s *SepaSctrResponse) GetSepaSctrs() []*SepaSctr {
+	if s != nil {
+		return s.SepaSctrs
 	}
-	return ""
+	return nil
 }
 
 // GetBankaccountID returns the BankaccountID field if it's non-nil, zero value otherwise.
@@ -2400,6 +3706,150 @@ func (t *TaxResidencesResponse) GetTaxResidences() []*TaxResidence {
 	return nil
 }
 
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetAmount() float64 {
+	if t != nil && t.Amount != nil {
+		return *t.Amount
+	}
+	return 0.0
+}
+
+// AmountMoney returns the Amount field as Money in the currency of Currency.
+func (t *Transaction) AmountMoney() Money {
+	if t == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(t.GetAmount(), t.Currency)
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetCreatedDate() TimestampParis {
+	if t != nil && t.CreatedDate != nil {
+		return *t.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetDescription() string {
+	if t != nil && t.Description != nil {
+		return *t.Description
+	}
+	return ""
+}
+
+// GetExecutionDate returns the ExecutionDate field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetExecutionDate() TimestampParis {
+	if t != nil && t.ExecutionDate != nil {
+		return *t.ExecutionDate
+	}
+	return TimestampParis{}
+}
+
+// GetForeignID returns the ForeignID field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetForeignID() string {
+	if t != nil && t.ForeignID != nil {
+		return *t.ForeignID
+	}
+	return ""
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetName() string {
+	if t != nil && t.Name != nil {
+		return *t.Name
+	}
+	return ""
+}
+
+// GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetTotalRows() int64 {
+	if t != nil && t.TotalRows != nil {
+		return *t.TotalRows
+	}
+	return 0
+}
+
+// GetTransactionID returns the TransactionID field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetTransactionID() string {
+	if t != nil && t.TransactionID != nil {
+		return *t.TransactionID
+	}
+	return ""
+}
+
+// GetTransactionType returns the TransactionType field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetTransactionType() string {
+	if t != nil && t.TransactionType != nil {
+		return *t.TransactionType
+	}
+	return ""
+}
+
+// GetValueDate returns the ValueDate field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetValueDate() Date {
+	if t != nil && t.ValueDate != nil {
+		return *t.ValueDate
+	}
+	return Date{}
+}
+
+// GetWalletCreditBalance returns the WalletCreditBalance field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetWalletCreditBalance() float64 {
+	if t != nil && t.WalletCreditBalance != nil {
+		return *t.WalletCreditBalance
+	}
+	return 0.0
+}
+
+// WalletCreditBalanceMoney returns the WalletCreditBalance field as Money in the currency of Currency.
+func (t *Transaction) WalletCreditBalanceMoney() Money {
+	if t == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(t.GetWalletCreditBalance(), t.Currency)
+}
+
+// GetWalletCreditID returns the WalletCreditID field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetWalletCreditID() string {
+	if t != nil && t.WalletCreditID != nil {
+		return *t.WalletCreditID
+	}
+	return ""
+}
+
+// GetWalletDebitBalance returns the WalletDebitBalance field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetWalletDebitBalance() float64 {
+	if t != nil && t.WalletDebitBalance != nil {
+		return *t.WalletDebitBalance
+	}
+	return 0.0
+}
+
+// WalletDebitBalanceMoney returns the WalletDebitBalance field as Money in the currency of Currency.
+func (t *Transaction) WalletDebitBalanceMoney() Money {
+	if t == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(t.GetWalletDebitBalance(), t.Currency)
+}
+
+// GetWalletDebitID returns the WalletDebitID field if it's non-nil, zero value otherwise.
+func (t *Transaction) GetWalletDebitID() string {
+	if t != nil && t.WalletDebitID != nil {
+		return *t.WalletDebitID
+	}
+	return ""
+}
+
+// GetTransactions returns the Transactions field.
+func (t *TransactionResponse) GetTransactions() []*Transaction {
+	if t != nil {
+		return t.Transactions
+	}
+	return nil
+}
+
 // GetAmount returns the Amount field if it's non-nil, zero value otherwise.
 func (t *Transfer) GetAmount() float64 {
 	if t != nil && t.Amount != nil {
@@ -2408,6 +3858,20 @@ func (t *Transfer) GetAmount() float64 {
 	return 0.0
 }
 
+// AmountMoney returns the Amount field as Money in the currency of Currency.
+func (t *Transfer) AmountMoney() Money {
+	if t == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(t.GetAmount(), t.Currency)
+}
+
+// SetAmountMoney sets the Amount and Currency fields from m.
+func (t *Transfer) SetAmountMoney(m Money) {
+	t.Amount = Float64(m.Float64())
+	t.Currency = m.Currency
+}
+
 // GetBeneficiaryWalletAlias returns the BeneficiaryWalletAlias field if it's non-nil, zero value otherwise.
 func (t *Transfer) GetBeneficiaryWalletAlias() string {
 	if t != nil && t.BeneficiaryWalletAlias != nil {
@@ -2536,6 +4000,132 @@ func (t *Transfer) GetWalletTypeID() string {
 	return ""
 }
 
+// GetAmount returns the Amount field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetAmount() float64 {
+	if t != nil && t.Amount != nil {
+		return *t.Amount
+	}
+	return 0.0
+}
+
+// AmountMoney returns the Amount field as Money in the currency of Currency.
+func (t *TransferRefund) AmountMoney() Money {
+	if t == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(t.GetAmount(), t.Currency)
+}
+
+// SetAmountMoney sets the Amount and Currency fields from m.
+func (t *TransferRefund) SetAmountMoney(m Money) {
+	t.Amount = Float64(m.Float64())
+	t.Currency = m.Currency
+}
+
+// GetCodeStatus returns the CodeStatus field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetCodeStatus() string {
+	if t != nil && t.CodeStatus != nil {
+		return *t.CodeStatus
+	}
+	return ""
+}
+
+// GetCreatedDate returns the CreatedDate field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetCreatedDate() TimestampParis {
+	if t != nil && t.CreatedDate != nil {
+		return *t.CreatedDate
+	}
+	return TimestampParis{}
+}
+
+// GetInformationStatus returns the InformationStatus field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetInformationStatus() string {
+	if t != nil && t.InformationStatus != nil {
+		return *t.InformationStatus
+	}
+	return ""
+}
+
+// GetLabel returns the Label field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetLabel() string {
+	if t != nil && t.Label != nil {
+		return *t.Label
+	}
+	return ""
+}
+
+// GetModifiedDate returns the ModifiedDate field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetModifiedDate() TimestampParis {
+	if t != nil && t.ModifiedDate != nil {
+		return *t.ModifiedDate
+	}
+	return TimestampParis{}
+}
+
+// GetTotalRows returns the TotalRows field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetTotalRows() int64 {
+	if t != nil && t.TotalRows != nil {
+		return *t.TotalRows
+	}
+	return 0
+}
+
+// GetTransferID returns the TransferID field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetTransferID() string {
+	if t != nil && t.TransferID != nil {
+		return *t.TransferID
+	}
+	return ""
+}
+
+// GetTransferRefundDate returns the TransferRefundDate field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetTransferRefundDate() Date {
+	if t != nil && t.TransferRefundDate != nil {
+		return *t.TransferRefundDate
+	}
+	return Date{}
+}
+
+// GetTransferRefundID returns the TransferRefundID field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetTransferRefundID() string {
+	if t != nil && t.TransferRefundID != nil {
+		return *t.TransferRefundID
+	}
+	return ""
+}
+
+// GetTransferRefundStatus returns the TransferRefundStatus field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetTransferRefundStatus() string {
+	if t != nil && t.TransferRefundStatus != nil {
+		return *t.TransferRefundStatus
+	}
+	return ""
+}
+
+// GetTransferRefundTag returns the TransferRefundTag field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetTransferRefundTag() string {
+	if t != nil && t.TransferRefundTag != nil {
+		return *t.TransferRefundTag
+	}
+	return ""
+}
+
+// GetWalletID returns the WalletID field if it's non-nil, zero value otherwise.
+func (t *TransferRefund) GetWalletID() string {
+	if t != nil && t.WalletID != nil {
+		return *t.WalletID
+	}
+	return ""
+}
+
+// GetTransferRefunds returns the TransferRefunds field.
+func (t *TransferRefundResponse) GetTransferRefunds() []*TransferRefund {
+	if t != nil {
+		return t.TransferRefunds
+	}
+	return nil
+}
+
 // GetTransfers returns the Transfers field.
 func (t *TransferResponse) GetTransfers() []*Transfer {
 	if t != nil {
@@ -3104,6 +4694,14 @@ func (w *Wallet) GetAuthorizedBalance() float64 {
 	return 0.0
 }
 
+// AuthorizedBalanceMoney returns the AuthorizedBalance field as Money in the currency of Currency.
+func (w *Wallet) AuthorizedBalanceMoney() Money {
+	if w == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(w.GetAuthorizedBalance(), w.Currency)
+}
+
 // GetBIC returns the BIC field if it's non-nil, zero value otherwise.
 func (w *Wallet) GetBIC() string {
 	if w != nil && w.BIC != nil {
@@ -3232,6 +4830,14 @@ func (w *Wallet) GetSolde() float64 {
 	return 0.0
 }
 
+// SoldeMoney returns the Solde field as Money in the currency of Currency.
+func (w *Wallet) SoldeMoney() Money {
+	if w == nil {
+		return Money{}
+	}
+	return MoneyFromFloat(w.GetSolde(), w.Currency)
+}
+
 // GetTariffID returns the TariffID field if it's non-nil, zero value otherwise.
 func (w *Wallet) GetTariffID() string {
 	if w != nil && w.TariffID != nil {
@@ -0,0 +1,30 @@
+// Code generated by gen_errors; DO NOT EDIT.
+
+package treezor
+
+// Treezor error codes.
+const (
+	ErrCodeInsufficientFunds = 15030
+	ErrCodeCardWrongPIN      = 32056
+	ErrCodeCardLost          = 32095
+	ErrCodeCardStolen        = 32096
+	ErrCodeCardBlocked       = 32111
+)
+
+// Sentinel errors for Treezor error codes. They can be matched against
+// errors returned by the services with errors.Is.
+var (
+	ErrInsufficientFunds = &ErrorCode{Code: ErrCodeInsufficientFunds, Name: "InsufficientFunds", Category: CategoryFunds, Retryable: false, Message: "insufficient funds on the wallet"}
+	ErrCardWrongPIN      = &ErrorCode{Code: ErrCodeCardWrongPIN, Name: "CardWrongPIN", Category: CategoryCard, Retryable: false, Message: "wrong card PIN"}
+	ErrCardLost          = &ErrorCode{Code: ErrCodeCardLost, Name: "CardLost", Category: CategoryCard, Retryable: false, Message: "card declared lost"}
+	ErrCardStolen        = &ErrorCode{Code: ErrCodeCardStolen, Name: "CardStolen", Category: CategoryCard, Retryable: false, Message: "card declared stolen"}
+	ErrCardBlocked       = &ErrorCode{Code: ErrCodeCardBlocked, Name: "CardBlocked", Category: CategoryCard, Retryable: false, Message: "card blocked"}
+)
+
+var errorCodes = map[int]*ErrorCode{
+	ErrCodeInsufficientFunds: ErrInsufficientFunds,
+	ErrCodeCardWrongPIN:      ErrCardWrongPIN,
+	ErrCodeCardLost:          ErrCardLost,
+	ErrCodeCardStolen:        ErrCardStolen,
+	ErrCodeCardBlocked:       ErrCardBlocked,
+}
@@ -0,0 +1,431 @@
+// Code generated by gen_mocks; DO NOT EDIT.
+
+package treezor
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// BalanceAPI is the interface implemented by BalanceService.
+// It is mocked by treezormock.BalanceAPI.
+type BalanceAPI interface {
+	// List the balances for the authenticated user. If WalletID is provided,
+	// list one balance for the specified wallet; if UserID is provided, list all
+	// the balances for the user's wallets.
+	List(ctx context.Context, opt *BalanceOptions) (*BalanceResponse, *http.Response, error)
+	// ListAll returns all the balances matching opt, walking every page.
+	ListAll(ctx context.Context, opt *BalanceOptions) ([]*Balance, error)
+}
+
+var _ BalanceAPI = (*BalanceService)(nil)
+
+// BankAccountAPI is the interface implemented by BankAccountService.
+// It is mocked by treezormock.BankAccountAPI.
+type BankAccountAPI interface {
+	// Cancel cancels a bank account. Its status is changed to CANCELED and it can
+	// no longer be used for pay-outs.
+	Cancel(ctx context.Context, bankAccountID string) (*BankAccount, *http.Response, error)
+	// Create creates a Treezor bank account.
+	// The required fields are UserID, BankAccountOwnerName, BankAccountOwnerAddress,
+	// BankAccountIBAN and BankAccountBIC.
+	Create(ctx context.Context, bankAccount *BankAccount) (*BankAccount, *http.Response, error)
+	// Get returns a bank account.
+	Get(ctx context.Context, bankAccountID string) (*BankAccount, *http.Response, error)
+	// List the bank accounts for the authenticated user.
+	List(ctx context.Context, opt *BankAccountListOptions) (*BankAccountResponse, *http.Response, error)
+	// ListAll returns all the bank accounts matching opt, walking every page.
+	ListAll(ctx context.Context, opt *BankAccountListOptions) ([]*BankAccount, error)
+}
+
+var _ BankAccountAPI = (*BankAccountService)(nil)
+
+// BeneficiaryAPI is the interface implemented by BeneficiaryService.
+// It is mocked by treezormock.BeneficiaryAPI.
+type BeneficiaryAPI interface {
+	// Create creates a Treezor beneficiary.
+	Create(ctx context.Context, beneficiary *BeneficiaryRequest) (*Beneficiary, *http.Response, error)
+	// Edit updates a beneficiary.
+	Edit(ctx context.Context, beneficiaryID string, beneficiary *BeneficiaryRequest) (*Beneficiary, *http.Response, error)
+	// Get returns a beneficiary.
+	Get(ctx context.Context, beneficiaryID string) (*Beneficiary, *http.Response, error)
+	// List the beneficiaries for the authenticated user.s
+	List(ctx context.Context, opt *BeneficiaryOptions) (*BeneficiaryResponse, *http.Response, error)
+	// ListAll returns all the beneficiaries matching opt, walking every page.
+	ListAll(ctx context.Context, opt *BeneficiaryOptions) ([]*Beneficiary, error)
+}
+
+var _ BeneficiaryAPI = (*BeneficiaryService)(nil)
+
+// CardAPI is the interface implemented by CardService.
+// It is mocked by treezormock.CardAPI.
+type CardAPI interface {
+	// Activate enable a card to make payments. It needs to be done only once.
+	Activate(ctx context.Context, cardID string) (*Card, *http.Response, error)
+	// AttachCountryGroup restricts the countries the card can pay in to the
+	// country restriction group groupID.
+	AttachCountryGroup(ctx context.Context, cardID string, groupID int64) (*Card, *http.Response, error)
+	// AttachMCCGroup restricts the MCCs the card can pay at to the MCC
+	// restriction group groupID.
+	AttachMCCGroup(ctx context.Context, cardID string, groupID int64) (*Card, *http.Response, error)
+	// AttachMerchantIDGroup restricts the merchants the card can pay at to the
+	// merchant ID restriction group groupID.
+	AttachMerchantIDGroup(ctx context.Context, cardID string, groupID int64) (*Card, *http.Response, error)
+	// ChangeLimits change a card' limits with the provided limits.
+	ChangeLimits(ctx context.Context, cardID string, limits *CardLimits) (*Card, *http.Response, error)
+	// ChangeOptions change a card' options with the provided options.
+	ChangeOptions(ctx context.Context, cardID string, options *CardOptions) (*Card, *http.Response, error)
+	// ChangePIN changes the card PIN. It needs the current PIN, the new one and a confirmation one.
+	ChangePIN(ctx context.Context, cardID string, pin *PIN) (*Card, *http.Response, error)
+	// ConvertVirtual will convert a virtual card to a physical one.
+	ConvertVirtual(ctx context.Context, cardID string) (*Card, *http.Response, error)
+	// CreateVirtual will create a virtual card.
+	CreateVirtual(ctx context.Context, card *Card) (*Card, *http.Response, error)
+	// Deactivate deactivates a card permanently.
+	Deactivate(ctx context.Context, cardID string) (*Card, *http.Response, error)
+	// Edit updates the referenced card (with cardID) in parameter.
+	Edit(ctx context.Context, cardID string, card *Card) (*Card, *http.Response, error)
+	// Get returns a card (virtual or physical).
+	Get(ctx context.Context, cardID string) (*Card, *http.Response, error)
+	// GetImage returns the provided virtual card image.
+	GetImage(ctx context.Context, opt *CardGetImagesOptions) (*CardImage, *http.Response, error)
+	// GetMany returns the cards with the given IDs, in the same order,
+	// fetching them concurrently. If some of them cannot be fetched, their entries
+	// are nil and a *GetManyError holding the error of each ID is returned.
+	GetMany(ctx context.Context, cardIDs []string, opt *GetManyOptions) ([]*Card, error)
+	// List the cards for the authenticated user.
+	List(ctx context.Context, opt *CardListOptions) (*CardResponse, *http.Response, error)
+	// ListAll returns all the cards matching opt, walking every page.
+	ListAll(ctx context.Context, opt *CardListOptions) ([]*Card, error)
+	// LockUnlock toggle the lock or unlock state of a card. If the card is locked, calling this function
+	// will unlock the card, and vice versa.
+	LockUnlock(ctx context.Context, cardID string, lockStatus LockStatus) (*Card, *http.Response, error)
+	// Regenerate will recreate or re-order the card given in parameter with the exact same configuration.
+	Regenerate(ctx context.Context, cardID string) (*Card, *http.Response, error)
+	// Register3DSecure will register a card to 3DSecure
+	Register3DSecure(ctx context.Context, cardID *Card3DS) (*Card, *http.Response, error)
+	// RequestPhysical will request a physical card that will be sent to the user's address.
+	RequestPhysical(ctx context.Context, card *Card) (*Card, *http.Response, error)
+	// SetPIN sets the card PIN. It needs the the new PIN and a confirmation one. It is solely used by operators,
+	// not users.
+	SetPIN(ctx context.Context, cardID string, pin *PIN) (*Card, *http.Response, error)
+	// UnblockPIN unlocks the card PIN if it was blocked because of 3 failed attempts.
+	UnblockPIN(ctx context.Context, cardID string) (*Card, *http.Response, error)
+}
+
+var _ CardAPI = (*CardService)(nil)
+
+// CardTransactionAPI is the interface implemented by CardTransactionService.
+// It is mocked by treezormock.CardTransactionAPI.
+type CardTransactionAPI interface {
+	// Get fetches a CardTransaction from Treezor.
+	Get(ctx context.Context, cardTransactionID string) (*CardTransaction, *http.Response, error)
+	// List the pay-ins for the authenticated user.
+	List(ctx context.Context, opt *CardTransactionsListOptions) (*CardTransactionResponse, *http.Response, error)
+	// ListAll returns all the card transactions matching opt, walking every page.
+	ListAll(ctx context.Context, opt *CardTransactionsListOptions) ([]*CardTransaction, error)
+}
+
+var _ CardTransactionAPI = (*CardTransactionService)(nil)
+
+// CountryGroupAPI is the interface implemented by CountryGroupService.
+// It is mocked by treezormock.CountryGroupAPI.
+type CountryGroupAPI interface {
+	// Cancel cancels a country restriction group. Its status is changed to CANCELED and it no
+	// longer restricts the cards.
+	Cancel(ctx context.Context, groupID int64) (*CountryGroup, *http.Response, error)
+	// Create creates a Treezor country restriction group.
+	// The required fields are Name and Countries. The group restricts the cards from StartDate.
+	Create(ctx context.Context, group *CountryGroup) (*CountryGroup, *http.Response, error)
+	// Edit updates the country restriction group groupID.
+	Edit(ctx context.Context, groupID int64, group *CountryGroup) (*CountryGroup, *http.Response, error)
+	// Get returns a country restriction group.
+	Get(ctx context.Context, groupID int64) (*CountryGroup, *http.Response, error)
+	// List the country restriction groups for the authenticated user.
+	List(ctx context.Context, opt *CountryGroupListOptions) (*CountryGroupResponse, *http.Response, error)
+	// ListAll returns all the country restriction groups matching opt, walking every page.
+	ListAll(ctx context.Context, opt *CountryGroupListOptions) ([]*CountryGroup, error)
+}
+
+var _ CountryGroupAPI = (*CountryGroupService)(nil)
+
+// DocumentAPI is the interface implemented by DocumentService.
+// It is mocked by treezormock.DocumentAPI.
+type DocumentAPI interface {
+	// Delete deletes a document in treezor
+	Delete(ctx context.Context, documentID string) (*http.Response, error)
+	// Get fetch document info from Treezor
+	Get(ctx context.Context, documentID string) (*Document, *http.Response, error)
+	// Send uploads the given file to Treezor for later KYC review.
+	Send(ctx context.Context, document *Document) (*Document, *http.Response, error)
+}
+
+var _ DocumentAPI = (*DocumentService)(nil)
+
+// HearthbeatAPI is the interface implemented by HearthbeatService.
+// It is mocked by treezormock.HearthbeatAPI.
+type HearthbeatAPI interface {
+	// Ping will try to reach the Treezor API. Returns true if the API is healthy, otherwise
+	// it returns false.
+	Ping(ctx context.Context) (bool, *http.Response, error)
+}
+
+var _ HearthbeatAPI = (*HearthbeatService)(nil)
+
+// MCCGroupAPI is the interface implemented by MCCGroupService.
+// It is mocked by treezormock.MCCGroupAPI.
+type MCCGroupAPI interface {
+	// Cancel cancels a MCC restriction group. Its status is changed to CANCELED and it no
+	// longer restricts the cards.
+	Cancel(ctx context.Context, groupID int64) (*MCCGroup, *http.Response, error)
+	// Create creates a Treezor MCC restriction group.
+	// The required fields are Name and MCC. The group restricts the cards from StartDate.
+	Create(ctx context.Context, group *MCCGroup) (*MCCGroup, *http.Response, error)
+	// Edit updates the MCC restriction group groupID.
+	Edit(ctx context.Context, groupID int64, group *MCCGroup) (*MCCGroup, *http.Response, error)
+	// Get returns a MCC restriction group.
+	Get(ctx context.Context, groupID int64) (*MCCGroup, *http.Response, error)
+	// List the MCC restriction groups for the authenticated user.
+	List(ctx context.Context, opt *MCCGroupListOptions) (*MCCGroupResponse, *http.Response, error)
+	// ListAll returns all the MCC restriction groups matching opt, walking every page.
+	ListAll(ctx context.Context, opt *MCCGroupListOptions) ([]*MCCGroup, error)
+}
+
+var _ MCCGroupAPI = (*MCCGroupService)(nil)
+
+// MandateAPI is the interface implemented by MandateService.
+// It is mocked by treezormock.MandateAPI.
+type MandateAPI interface {
+	// Cancel cancels a mandate. Its status is changed to CANCELED and it can no
+	// longer be used by SDD pay-ins.
+	Cancel(ctx context.Context, mandateID string, opt *MandateCancelOptions) (*Mandate, *http.Response, error)
+	// Create creates a Treezor mandate.
+	// The required fields are UserID, SequenceType, DebtorName, DebtorAddress,
+	// DebtorCity, DebtorZipCode, DebtorCountry, DebtorIBAN and CreatedIP.
+	Create(ctx context.Context, mandate *Mandate) (*Mandate, *http.Response, error)
+	// Get returns a mandate.
+	Get(ctx context.Context, mandateID string) (*Mandate, *http.Response, error)
+	// GetPDF writes the PDF document of a mandate to w.
+	GetPDF(ctx context.Context, mandateID string, w io.Writer) (*http.Response, error)
+	// List the mandates for the authenticated user.
+	List(ctx context.Context, opt *MandateListOptions) (*MandateResponse, *http.Response, error)
+	// ListAll returns all the mandates matching opt, walking every page.
+	ListAll(ctx context.Context, opt *MandateListOptions) ([]*Mandate, error)
+	// RequestSignatureOTP sends the debtor of a PENDING mandate the one time
+	// password needed by Sign.
+	RequestSignatureOTP(ctx context.Context, mandateID string) (*Mandate, *http.Response, error)
+	// Sign signs a PENDING mandate with the one time password received by its
+	// debtor. The mandate is VALIDATED once signed.
+	Sign(ctx context.Context, mandateID string, signature *MandateSignature) (*Mandate, *http.Response, error)
+}
+
+var _ MandateAPI = (*MandateService)(nil)
+
+// MerchantIDGroupAPI is the interface implemented by MerchantIDGroupService.
+// It is mocked by treezormock.MerchantIDGroupAPI.
+type MerchantIDGroupAPI interface {
+	// Cancel cancels a merchant ID restriction group. Its status is changed to CANCELED and it no
+	// longer restricts the cards.
+	Cancel(ctx context.Context, groupID int64) (*MerchantIDGroup, *http.Response, error)
+	// Create creates a Treezor merchant ID restriction group.
+	// The required fields are Name and Merchants. The group restricts the cards from StartDate.
+	Create(ctx context.Context, group *MerchantIDGroup) (*MerchantIDGroup, *http.Response, error)
+	// Edit updates the merchant ID restriction group groupID.
+	Edit(ctx context.Context, groupID int64, group *MerchantIDGroup) (*MerchantIDGroup, *http.Response, error)
+	// Get returns a merchant ID restriction group.
+	Get(ctx context.Context, groupID int64) (*MerchantIDGroup, *http.Response, error)
+	// List the merchant ID restriction groups for the authenticated user.
+	List(ctx context.Context, opt *MerchantIDGroupListOptions) (*MerchantIDGroupResponse, *http.Response, error)
+	// ListAll returns all the merchant ID restriction groups matching opt, walking every page.
+	ListAll(ctx context.Context, opt *MerchantIDGroupListOptions) ([]*MerchantIDGroup, error)
+}
+
+var _ MerchantIDGroupAPI = (*MerchantIDGroupService)(nil)
+
+// PayinRefundAPI is the interface implemented by PayinRefundService.
+// It is mocked by treezormock.PayinRefundAPI.
+type PayinRefundAPI interface {
+	// Cancel cancels a pay-in refund. Its status is changed to CANCELED. A validated
+	// pay-in refund can't be canceled.
+	Cancel(ctx context.Context, payinRefundID string) (*PayinRefund, *http.Response, error)
+	// Create creates a Treezor pay-in refund. The required field is PayinID.
+	// The pay-in and Amount are checked before the refund is created; see refundAmount.
+	Create(ctx context.Context, refund *PayinRefund) (*PayinRefund, *http.Response, error)
+	// Get returns a pay-in refund.
+	Get(ctx context.Context, payinRefundID string) (*PayinRefund, *http.Response, error)
+	// List the pay-in refunds for the authenticated user.
+	List(ctx context.Context, opt *PayinRefundListOptions) (*PayinRefundResponse, *http.Response, error)
+	// ListAll returns all the pay-in refunds matching opt, walking every page.
+	ListAll(ctx context.Context, opt *PayinRefundListOptions) ([]*PayinRefund, error)
+}
+
+var _ PayinRefundAPI = (*PayinRefundService)(nil)
+
+// PayinAPI is the interface implemented by PayinService.
+// It is mocked by treezormock.PayinAPI.
+type PayinAPI interface {
+	// Create creates a Treezor pay-in.
+	// The required field are WalletID, BeneficiaryID, Amount, Currency(ISO 4217).
+	Create(ctx context.Context, payin *Payin) (*Payin, *http.Response, error)
+	// Delete deletes a payin. Change payin's status to CANCELED. A validated payin can't be cancelled.
+	Delete(ctx context.Context, payinID string) (*Payin, *http.Response, error)
+	// Get returns a pay-in.
+	Get(ctx context.Context, payinID string) (*Payin, *http.Response, error)
+	// GetMany returns the pay-ins with the given IDs, in the same order,
+	// fetching them concurrently. If some of them cannot be fetched, their entries
+	// are nil and a *GetManyError holding the error of each ID is returned.
+	GetMany(ctx context.Context, payinIDs []string, opt *GetManyOptions) ([]*Payin, error)
+	// List the pay-ins for the authenticated user.
+	List(ctx context.Context, opt *PayinListOptions) (*PayinResponse, *http.Response, error)
+	// ListAll returns all the pay-ins matching opt, walking every page.
+	ListAll(ctx context.Context, opt *PayinListOptions) ([]*Payin, error)
+}
+
+var _ PayinAPI = (*PayinService)(nil)
+
+// PayoutAPI is the interface implemented by PayoutService.
+// It is mocked by treezormock.PayoutAPI.
+type PayoutAPI interface {
+	// Create creates a Treezor pay-out.
+	// The required field are WalletID, BeneficiaryID, Amount, Currency(ISO 4217).
+	// It returns ErrInvalidCurrency, without calling the API, if Currency is not a
+	// known ISO 4217 code.
+	Create(ctx context.Context, payout *Payout) (*Payout, *http.Response, error)
+	// Delete deletes a payout. Change payout's status to CANCELED. A validated payout can't be cancelled.
+	Delete(ctx context.Context, payoutID string) (*Payout, *http.Response, error)
+	// Get returns a pay-out.
+	Get(ctx context.Context, payoutID string) (*Payout, *http.Response, error)
+	// GetMany returns the pay-outs with the given IDs, in the same order,
+	// fetching them concurrently. If some of them cannot be fetched, their entries
+	// are nil and a *GetManyError holding the error of each ID is returned.
+	GetMany(ctx context.Context, payoutIDs []string, opt *GetManyOptions) ([]*Payout, error)
+	// List the pay-outs for the authenticated user.
+	List(ctx context.Context, opt *PayoutListOptions) (*PayoutResponse, *http.Response, error)
+	// ListAll returns all the pay-outs matching opt, walking every page.
+	ListAll(ctx context.Context, opt *PayoutListOptions) ([]*Payout, error)
+}
+
+var _ PayoutAPI = (*PayoutService)(nil)
+
+// TaxResidencesAPI is the interface implemented by TaxResidencesService.
+// It is mocked by treezormock.TaxResidencesAPI.
+type TaxResidencesAPI interface {
+	// Create tax residences in Treezor.
+	Create(ctx context.Context, taxResidence *TaxResidence) (*TaxResidence, *http.Response, error)
+	// Edit updates a tax residences.
+	Edit(ctx context.Context, taxResidenceID int64, taxResidence *TaxResidence) (*TaxResidence, *http.Response, error)
+}
+
+var _ TaxResidencesAPI = (*TaxResidencesService)(nil)
+
+// TransactionAPI is the interface implemented by TransactionService.
+// It is mocked by treezormock.TransactionAPI.
+type TransactionAPI interface {
+	// Get returns a transaction.
+	Get(ctx context.Context, transactionID string) (*Transaction, *http.Response, error)
+	// List the transactions for the authenticated user.
+	List(ctx context.Context, opt *TransactionListOptions) (*TransactionResponse, *http.Response, error)
+	// ListAll returns all the transactions matching opt, walking every page.
+	ListAll(ctx context.Context, opt *TransactionListOptions) ([]*Transaction, error)
+}
+
+var _ TransactionAPI = (*TransactionService)(nil)
+
+// TransferRefundAPI is the interface implemented by TransferRefundService.
+// It is mocked by treezormock.TransferRefundAPI.
+type TransferRefundAPI interface {
+	// Cancel cancels a transfer refund. Its status is changed to CANCELED. A validated
+	// transfer refund can't be canceled.
+	Cancel(ctx context.Context, transferRefundID string) (*TransferRefund, *http.Response, error)
+	// Create creates a Treezor transfer refund. The required field is TransferID.
+	// The transfer and Amount are checked before the refund is created; see refundAmount.
+	Create(ctx context.Context, refund *TransferRefund) (*TransferRefund, *http.Response, error)
+	// Get returns a transfer refund.
+	Get(ctx context.Context, transferRefundID string) (*TransferRefund, *http.Response, error)
+	// List the transfer refunds for the authenticated user.
+	List(ctx context.Context, opt *TransferRefundListOptions) (*TransferRefundResponse, *http.Response, error)
+	// ListAll returns all the transfer refunds matching opt, walking every page.
+	ListAll(ctx context.Context, opt *TransferRefundListOptions) ([]*TransferRefund, error)
+}
+
+var _ TransferRefundAPI = (*TransferRefundService)(nil)
+
+// TransferAPI is the interface implemented by TransferService.
+// It is mocked by treezormock.TransferAPI.
+type TransferAPI interface {
+	// Create creates a Treezor transfer. Required: WalletID, BeneficiaryWalletID,Amount,Currency(ISO 4217)
+	// It returns ErrInvalidCurrency, without calling the API, if Currency is not a
+	// known ISO 4217 code.
+	Create(ctx context.Context, transfer *Transfer) (*Transfer, *http.Response, error)
+	// Delete deletes a transfer. Change transfer's status to CANCELED. A validated transfer can't be cancelled.
+	Delete(ctx context.Context, transferID string) (*Transfer, *http.Response, error)
+	// Get returns a transfer.
+	Get(ctx context.Context, transferID string) (*Transfer, *http.Response, error)
+	// GetMany returns the transfers with the given IDs, in the same order,
+	// fetching them concurrently. If some of them cannot be fetched, their entries
+	// are nil and a *GetManyError holding the error of each ID is returned.
+	GetMany(ctx context.Context, transferIDs []string, opt *GetManyOptions) ([]*Transfer, error)
+	// List the transfers for the authenticated user.s
+	List(ctx context.Context, opt *TransferListOptions) (*TransferResponse, *http.Response, error)
+	// ListAll returns all the transfers matching opt, walking every page.
+	ListAll(ctx context.Context, opt *TransferListOptions) ([]*Transfer, error)
+}
+
+var _ TransferAPI = (*TransferService)(nil)
+
+// UserAPI is the interface implemented by UserService.
+// It is mocked by treezormock.UserAPI.
+type UserAPI interface {
+	// Cancel makes a User cancelled, meaning all future operation for that user
+	// will be refused.
+	Cancel(ctx context.Context, userID string, opt *UserCancelOptions) (*User, *http.Response, error)
+	// Create creates a Treezor user.
+	Create(ctx context.Context, user *User) (*User, *http.Response, error)
+	// Edit updates a user.
+	Edit(ctx context.Context, userID string, user *User) (*User, *http.Response, error)
+	// Get fetches a user from Treezor.
+	Get(ctx context.Context, userID string) (*User, *http.Response, error)
+	// GetMany returns the users with the given IDs, in the same order,
+	// fetching them concurrently. If some of them cannot be fetched, their entries
+	// are nil and a *GetManyError holding the error of each ID is returned.
+	GetMany(ctx context.Context, userIDs []string, opt *GetManyOptions) ([]*User, error)
+	// List returns a list of users.
+	List(ctx context.Context, opt *UserListOptions) (*UserResponse, *http.Response, error)
+	// ListAll returns all the users matching opt, walking every page.
+	ListAll(ctx context.Context, opt *UserListOptions) ([]*User, error)
+	// RequestKYCLiveness makes a kyc url request for the kycliveness process.
+	RequestKYCLiveness(ctx context.Context, treezorUserID string) (*Identification, *http.Response, error)
+	// ReviewKYC asks Treezor to do a KYC review against that user.
+	ReviewKYC(ctx context.Context, userID string) (*User, *http.Response, error)
+	// ReviewKYCLiveness asks Treezor to do a KYC review against that user.
+	ReviewKYCLiveness(ctx context.Context, treezorUserID string) (*http.Response, error)
+}
+
+var _ UserAPI = (*UserService)(nil)
+
+// WalletAPI is the interface implemented by WalletService.
+// It is mocked by treezormock.WalletAPI.
+type WalletAPI interface {
+	// Cancel makes a User cancelled, meaning all future operation for that wallet
+	// will be refused.
+	Cancel(ctx context.Context, walletID string, opt *WalletCancelOptions) (*Wallet, *http.Response, error)
+	// Create creates a Treezor wallet.
+	// It returns ErrInvalidCurrency, without calling the API, if Currency is not a
+	// known ISO 4217 code.
+	Create(ctx context.Context, wallet *Wallet) (*Wallet, *http.Response, error)
+	// Edit updates a wallet.
+	Edit(ctx context.Context, walletID string, wallet *Wallet) (*Wallet, *http.Response, error)
+	// Get fetches a wallet from Treezor.
+	Get(ctx context.Context, walletID string) (*Wallet, *http.Response, error)
+	// GetMany returns the wallets with the given IDs, in the same order,
+	// fetching them concurrently. If some of them cannot be fetched, their entries
+	// are nil and a *GetManyError holding the error of each ID is returned.
+	GetMany(ctx context.Context, walletIDs []string, opt *GetManyOptions) ([]*Wallet, error)
+	// List returns a list of wallets.
+	List(ctx context.Context, opt *WalletListOptions) (*WalletResponse, *http.Response, error)
+	// ListAll returns all the wallets matching opt, walking every page.
+	ListAll(ctx context.Context, opt *WalletListOptions) ([]*Wallet, error)
+}
+
+var _ WalletAPI = (*WalletService)(nil)
@@ -0,0 +1,1114 @@
+// Code generated by gen_iterators; DO NOT EDIT.
+
+package treezor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// BalanceIterator walks all the balances matching a BalanceService.List query,
+// fetching pages on demand.
+type BalanceIterator struct {
+	pageIterator
+	page  []*Balance
+	items []*Balance
+	cur   *Balance
+}
+
+// Iterate returns an iterator over all the balances matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *BalanceService) Iterate(ctx context.Context, opt *BalanceOptions) *BalanceIterator {
+	o := new(BalanceOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(BalanceIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Balances
+		if len(r.Balances) == 0 {
+			return page{}, nil
+		}
+		first := r.Balances[0]
+		return page{n: len(r.Balances), firstID: first.GetWalletID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next balance. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *BalanceIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Balance returns the current balance.
+func (it *BalanceIterator) Balance() *Balance {
+	return it.cur
+}
+
+// ListAll returns all the balances matching opt, walking every page.
+func (s *BalanceService) ListAll(ctx context.Context, opt *BalanceOptions) ([]*Balance, error) {
+	var all []*Balance
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Balance())
+	}
+	return all, it.Err()
+}
+
+// BankAccountIterator walks all the bank accounts matching a BankAccountService.List query,
+// fetching pages on demand.
+type BankAccountIterator struct {
+	pageIterator
+	page  []*BankAccount
+	items []*BankAccount
+	cur   *BankAccount
+}
+
+// Iterate returns an iterator over all the bank accounts matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *BankAccountService) Iterate(ctx context.Context, opt *BankAccountListOptions) *BankAccountIterator {
+	o := new(BankAccountListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(BankAccountIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.BankAccounts
+		if len(r.BankAccounts) == 0 {
+			return page{}, nil
+		}
+		first := r.BankAccounts[0]
+		return page{n: len(r.BankAccounts), totalRows: first.GetTotalRows(), firstID: first.GetBankAccountID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next bank account. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *BankAccountIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// BankAccount returns the current bank account.
+func (it *BankAccountIterator) BankAccount() *BankAccount {
+	return it.cur
+}
+
+// ListAll returns all the bank accounts matching opt, walking every page.
+func (s *BankAccountService) ListAll(ctx context.Context, opt *BankAccountListOptions) ([]*BankAccount, error) {
+	var all []*BankAccount
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.BankAccount())
+	}
+	return all, it.Err()
+}
+
+// BeneficiaryIterator walks all the beneficiaries matching a BeneficiaryService.List query,
+// fetching pages on demand.
+type BeneficiaryIterator struct {
+	pageIterator
+	page  []*Beneficiary
+	items []*Beneficiary
+	cur   *Beneficiary
+}
+
+// Iterate returns an iterator over all the beneficiaries matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *BeneficiaryService) Iterate(ctx context.Context, opt *BeneficiaryOptions) *BeneficiaryIterator {
+	o := new(BeneficiaryOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(BeneficiaryIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Beneficiaries
+		if len(r.Beneficiaries) == 0 {
+			return page{}, nil
+		}
+		first := r.Beneficiaries[0]
+		return page{n: len(r.Beneficiaries), firstID: first.GetBeneficiaryID().String()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next beneficiary. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *BeneficiaryIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Beneficiary returns the current beneficiary.
+func (it *BeneficiaryIterator) Beneficiary() *Beneficiary {
+	return it.cur
+}
+
+// ListAll returns all the beneficiaries matching opt, walking every page.
+func (s *BeneficiaryService) ListAll(ctx context.Context, opt *BeneficiaryOptions) ([]*Beneficiary, error) {
+	var all []*Beneficiary
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Beneficiary())
+	}
+	return all, it.Err()
+}
+
+// CardIterator walks all the cards matching a CardService.List query,
+// fetching pages on demand.
+type CardIterator struct {
+	pageIterator
+	page  []*Card
+	items []*Card
+	cur   *Card
+}
+
+// Iterate returns an iterator over all the cards matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *CardService) Iterate(ctx context.Context, opt *CardListOptions) *CardIterator {
+	o := new(CardListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(CardIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Cards
+		if len(r.Cards) == 0 {
+			return page{}, nil
+		}
+		first := r.Cards[0]
+		return page{n: len(r.Cards), totalRows: first.GetTotalRows(), firstID: first.GetCardID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next card. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *CardIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Card returns the current card.
+func (it *CardIterator) Card() *Card {
+	return it.cur
+}
+
+// ListAll returns all the cards matching opt, walking every page.
+func (s *CardService) ListAll(ctx context.Context, opt *CardListOptions) ([]*Card, error) {
+	var all []*Card
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Card())
+	}
+	return all, it.Err()
+}
+
+// CardTransactionIterator walks all the card transactions matching a CardTransactionService.List query,
+// fetching pages on demand.
+type CardTransactionIterator struct {
+	pageIterator
+	page  []*CardTransaction
+	items []*CardTransaction
+	cur   *CardTransaction
+}
+
+// Iterate returns an iterator over all the card transactions matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *CardTransactionService) Iterate(ctx context.Context, opt *CardTransactionsListOptions) *CardTransactionIterator {
+	o := new(CardTransactionsListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(CardTransactionIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.CardTransactions
+		if len(r.CardTransactions) == 0 {
+			return page{}, nil
+		}
+		first := r.CardTransactions[0]
+		return page{n: len(r.CardTransactions), firstID: first.GetCardTransactionID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next card transaction. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *CardTransactionIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// CardTransaction returns the current card transaction.
+func (it *CardTransactionIterator) CardTransaction() *CardTransaction {
+	return it.cur
+}
+
+// ListAll returns all the card transactions matching opt, walking every page.
+func (s *CardTransactionService) ListAll(ctx context.Context, opt *CardTransactionsListOptions) ([]*CardTransaction, error) {
+	var all []*CardTransaction
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.CardTransaction())
+	}
+	return all, it.Err()
+}
+
+// CountryGroupIterator walks all the country restriction groups matching a CountryGroupService.List query,
+// fetching pages on demand.
+type CountryGroupIterator struct {
+	pageIterator
+	page  []*CountryGroup
+	items []*CountryGroup
+	cur   *CountryGroup
+}
+
+// Iterate returns an iterator over all the country restriction groups matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *CountryGroupService) Iterate(ctx context.Context, opt *CountryGroupListOptions) *CountryGroupIterator {
+	o := new(CountryGroupListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(CountryGroupIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.CountryGroups
+		if len(r.CountryGroups) == 0 {
+			return page{}, nil
+		}
+		first := r.CountryGroups[0]
+		return page{n: len(r.CountryGroups), firstID: int64ID(first.GetID())}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next country restriction group. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *CountryGroupIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// CountryGroup returns the current country restriction group.
+func (it *CountryGroupIterator) CountryGroup() *CountryGroup {
+	return it.cur
+}
+
+// ListAll returns all the country restriction groups matching opt, walking every page.
+func (s *CountryGroupService) ListAll(ctx context.Context, opt *CountryGroupListOptions) ([]*CountryGroup, error) {
+	var all []*CountryGroup
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.CountryGroup())
+	}
+	return all, it.Err()
+}
+
+// MCCGroupIterator walks all the MCC restriction groups matching a MCCGroupService.List query,
+// fetching pages on demand.
+type MCCGroupIterator struct {
+	pageIterator
+	page  []*MCCGroup
+	items []*MCCGroup
+	cur   *MCCGroup
+}
+
+// Iterate returns an iterator over all the MCC restriction groups matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *MCCGroupService) Iterate(ctx context.Context, opt *MCCGroupListOptions) *MCCGroupIterator {
+	o := new(MCCGroupListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(MCCGroupIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.MCCGroups
+		if len(r.MCCGroups) == 0 {
+			return page{}, nil
+		}
+		first := r.MCCGroups[0]
+		return page{n: len(r.MCCGroups), firstID: int64ID(first.GetID())}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next MCC restriction group. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *MCCGroupIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// MCCGroup returns the current MCC restriction group.
+func (it *MCCGroupIterator) MCCGroup() *MCCGroup {
+	return it.cur
+}
+
+// ListAll returns all the MCC restriction groups matching opt, walking every page.
+func (s *MCCGroupService) ListAll(ctx context.Context, opt *MCCGroupListOptions) ([]*MCCGroup, error) {
+	var all []*MCCGroup
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.MCCGroup())
+	}
+	return all, it.Err()
+}
+
+// MandateIterator walks all the mandates matching a MandateService.List query,
+// fetching pages on demand.
+type MandateIterator struct {
+	pageIterator
+	page  []*Mandate
+	items []*Mandate
+	cur   *Mandate
+}
+
+// Iterate returns an iterator over all the mandates matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *MandateService) Iterate(ctx context.Context, opt *MandateListOptions) *MandateIterator {
+	o := new(MandateListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(MandateIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Mandates
+		if len(r.Mandates) == 0 {
+			return page{}, nil
+		}
+		first := r.Mandates[0]
+		return page{n: len(r.Mandates), totalRows: first.GetTotalRows(), firstID: first.GetMandateID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next mandate. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *MandateIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Mandate returns the current mandate.
+func (it *MandateIterator) Mandate() *Mandate {
+	return it.cur
+}
+
+// ListAll returns all the mandates matching opt, walking every page.
+func (s *MandateService) ListAll(ctx context.Context, opt *MandateListOptions) ([]*Mandate, error) {
+	var all []*Mandate
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Mandate())
+	}
+	return all, it.Err()
+}
+
+// MerchantIDGroupIterator walks all the merchant ID restriction groups matching a MerchantIDGroupService.List query,
+// fetching pages on demand.
+type MerchantIDGroupIterator struct {
+	pageIterator
+	page  []*MerchantIDGroup
+	items []*MerchantIDGroup
+	cur   *MerchantIDGroup
+}
+
+// Iterate returns an iterator over all the merchant ID restriction groups matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *MerchantIDGroupService) Iterate(ctx context.Context, opt *MerchantIDGroupListOptions) *MerchantIDGroupIterator {
+	o := new(MerchantIDGroupListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(MerchantIDGroupIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.MerchantIDGroups
+		if len(r.MerchantIDGroups) == 0 {
+			return page{}, nil
+		}
+		first := r.MerchantIDGroups[0]
+		return page{n: len(r.MerchantIDGroups), firstID: int64ID(first.GetID())}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next merchant ID restriction group. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *MerchantIDGroupIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// MerchantIDGroup returns the current merchant ID restriction group.
+func (it *MerchantIDGroupIterator) MerchantIDGroup() *MerchantIDGroup {
+	return it.cur
+}
+
+// ListAll returns all the merchant ID restriction groups matching opt, walking every page.
+func (s *MerchantIDGroupService) ListAll(ctx context.Context, opt *MerchantIDGroupListOptions) ([]*MerchantIDGroup, error) {
+	var all []*MerchantIDGroup
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.MerchantIDGroup())
+	}
+	return all, it.Err()
+}
+
+// PayinRefundIterator walks all the pay-in refunds matching a PayinRefundService.List query,
+// fetching pages on demand.
+type PayinRefundIterator struct {
+	pageIterator
+	page  []*PayinRefund
+	items []*PayinRefund
+	cur   *PayinRefund
+}
+
+// Iterate returns an iterator over all the pay-in refunds matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *PayinRefundService) Iterate(ctx context.Context, opt *PayinRefundListOptions) *PayinRefundIterator {
+	o := new(PayinRefundListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(PayinRefundIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.PayinRefunds
+		if len(r.PayinRefunds) == 0 {
+			return page{}, nil
+		}
+		first := r.PayinRefunds[0]
+		return page{n: len(r.PayinRefunds), totalRows: first.GetTotalRows(), firstID: first.GetPayinRefundID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next pay-in refund. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *PayinRefundIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// PayinRefund returns the current pay-in refund.
+func (it *PayinRefundIterator) PayinRefund() *PayinRefund {
+	return it.cur
+}
+
+// ListAll returns all the pay-in refunds matching opt, walking every page.
+func (s *PayinRefundService) ListAll(ctx context.Context, opt *PayinRefundListOptions) ([]*PayinRefund, error) {
+	var all []*PayinRefund
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.PayinRefund())
+	}
+	return all, it.Err()
+}
+
+// PayinIterator walks all the pay-ins matching a PayinService.List query,
+// fetching pages on demand.
+type PayinIterator struct {
+	pageIterator
+	page  []*Payin
+	items []*Payin
+	cur   *Payin
+}
+
+// Iterate returns an iterator over all the pay-ins matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *PayinService) Iterate(ctx context.Context, opt *PayinListOptions) *PayinIterator {
+	o := new(PayinListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(PayinIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Payins
+		if len(r.Payins) == 0 {
+			return page{}, nil
+		}
+		first := r.Payins[0]
+		return page{n: len(r.Payins), totalRows: first.GetTotalRows(), firstID: first.GetPayinID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next pay-in. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *PayinIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Payin returns the current pay-in.
+func (it *PayinIterator) Payin() *Payin {
+	return it.cur
+}
+
+// ListAll returns all the pay-ins matching opt, walking every page.
+func (s *PayinService) ListAll(ctx context.Context, opt *PayinListOptions) ([]*Payin, error) {
+	var all []*Payin
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Payin())
+	}
+	return all, it.Err()
+}
+
+// PayoutIterator walks all the pay-outs matching a PayoutService.List query,
+// fetching pages on demand.
+type PayoutIterator struct {
+	pageIterator
+	page  []*Payout
+	items []*Payout
+	cur   *Payout
+}
+
+// Iterate returns an iterator over all the pay-outs matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *PayoutService) Iterate(ctx context.Context, opt *PayoutListOptions) *PayoutIterator {
+	o := new(PayoutListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(PayoutIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Payouts
+		if len(r.Payouts) == 0 {
+			return page{}, nil
+		}
+		first := r.Payouts[0]
+		return page{n: len(r.Payouts), totalRows: first.GetTotalRows(), firstID: first.GetPayoutID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next pay-out. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *PayoutIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Payout returns the current pay-out.
+func (it *PayoutIterator) Payout() *Payout {
+	return it.cur
+}
+
+// ListAll returns all the pay-outs matching opt, walking every page.
+func (s *PayoutService) ListAll(ctx context.Context, opt *PayoutListOptions) ([]*Payout, error) {
+	var all []*Payout
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Payout())
+	}
+	return all, it.Err()
+}
+
+// TransactionIterator walks all the transactions matching a TransactionService.List query,
+// fetching pages on demand.
+type TransactionIterator struct {
+	pageIterator
+	page  []*Transaction
+	items []*Transaction
+	cur   *Transaction
+}
+
+// Iterate returns an iterator over all the transactions matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *TransactionService) Iterate(ctx context.Context, opt *TransactionListOptions) *TransactionIterator {
+	o := new(TransactionListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(TransactionIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Transactions
+		if len(r.Transactions) == 0 {
+			return page{}, nil
+		}
+		first := r.Transactions[0]
+		return page{n: len(r.Transactions), totalRows: first.GetTotalRows(), firstID: first.GetTransactionID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next transaction. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *TransactionIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Transaction returns the current transaction.
+func (it *TransactionIterator) Transaction() *Transaction {
+	return it.cur
+}
+
+// ListAll returns all the transactions matching opt, walking every page.
+func (s *TransactionService) ListAll(ctx context.Context, opt *TransactionListOptions) ([]*Transaction, error) {
+	var all []*Transaction
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Transaction())
+	}
+	return all, it.Err()
+}
+
+// TransferRefundIterator walks all the transfer refunds matching a TransferRefundService.List query,
+// fetching pages on demand.
+type TransferRefundIterator struct {
+	pageIterator
+	page  []*TransferRefund
+	items []*TransferRefund
+	cur   *TransferRefund
+}
+
+// Iterate returns an iterator over all the transfer refunds matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *TransferRefundService) Iterate(ctx context.Context, opt *TransferRefundListOptions) *TransferRefundIterator {
+	o := new(TransferRefundListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(TransferRefundIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.TransferRefunds
+		if len(r.TransferRefunds) == 0 {
+			return page{}, nil
+		}
+		first := r.TransferRefunds[0]
+		return page{n: len(r.TransferRefunds), totalRows: first.GetTotalRows(), firstID: first.GetTransferRefundID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next transfer refund. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *TransferRefundIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// TransferRefund returns the current transfer refund.
+func (it *TransferRefundIterator) TransferRefund() *TransferRefund {
+	return it.cur
+}
+
+// ListAll returns all the transfer refunds matching opt, walking every page.
+func (s *TransferRefundService) ListAll(ctx context.Context, opt *TransferRefundListOptions) ([]*TransferRefund, error) {
+	var all []*TransferRefund
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.TransferRefund())
+	}
+	return all, it.Err()
+}
+
+// TransferIterator walks all the transfers matching a TransferService.List query,
+// fetching pages on demand.
+type TransferIterator struct {
+	pageIterator
+	page  []*Transfer
+	items []*Transfer
+	cur   *Transfer
+}
+
+// Iterate returns an iterator over all the transfers matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *TransferService) Iterate(ctx context.Context, opt *TransferListOptions) *TransferIterator {
+	o := new(TransferListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(TransferIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Transfers
+		if len(r.Transfers) == 0 {
+			return page{}, nil
+		}
+		first := r.Transfers[0]
+		return page{n: len(r.Transfers), totalRows: first.GetTotalRows(), firstID: first.GetTransferID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next transfer. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *TransferIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Transfer returns the current transfer.
+func (it *TransferIterator) Transfer() *Transfer {
+	return it.cur
+}
+
+// ListAll returns all the transfers matching opt, walking every page.
+func (s *TransferService) ListAll(ctx context.Context, opt *TransferListOptions) ([]*Transfer, error) {
+	var all []*Transfer
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Transfer())
+	}
+	return all, it.Err()
+}
+
+// UserIterator walks all the users matching a UserService.List query,
+// fetching pages on demand.
+type UserIterator struct {
+	pageIterator
+	page  []*User
+	items []*User
+	cur   *User
+}
+
+// Iterate returns an iterator over all the users matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *UserService) Iterate(ctx context.Context, opt *UserListOptions) *UserIterator {
+	o := new(UserListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(UserIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Users
+		if len(r.Users) == 0 {
+			return page{}, nil
+		}
+		first := r.Users[0]
+		return page{n: len(r.Users), totalRows: first.GetTotalRows(), firstID: first.GetUserID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next user. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *UserIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// User returns the current user.
+func (it *UserIterator) User() *User {
+	return it.cur
+}
+
+// ListAll returns all the users matching opt, walking every page.
+func (s *UserService) ListAll(ctx context.Context, opt *UserListOptions) ([]*User, error) {
+	var all []*User
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.User())
+	}
+	return all, it.Err()
+}
+
+// WalletIterator walks all the wallets matching a WalletService.List query,
+// fetching pages on demand.
+type WalletIterator struct {
+	pageIterator
+	page  []*Wallet
+	items []*Wallet
+	cur   *Wallet
+}
+
+// Iterate returns an iterator over all the wallets matching opt, starting at
+// opt.Page. It stops when the API runs out of results, when opt.MaxItems is
+// reached or when ctx is done.
+func (s *WalletService) Iterate(ctx context.Context, opt *WalletListOptions) *WalletIterator {
+	o := new(WalletListOptions)
+	if opt != nil {
+		*o = *opt
+	}
+
+	it := new(WalletIterator)
+	it.pageIterator = newPageIterator(ctx, &o.ListOptions, func(ctx context.Context) (page, error) {
+		r, _, err := s.List(ctx, o)
+		if err != nil {
+			return page{}, errors.WithStack(err)
+		}
+		it.page = r.Wallets
+		if len(r.Wallets) == 0 {
+			return page{}, nil
+		}
+		first := r.Wallets[0]
+		return page{n: len(r.Wallets), totalRows: first.GetTotalRows(), firstID: first.GetWalletID()}, nil
+	})
+	return it
+}
+
+// Next advances the iterator to the next wallet. It returns false when the
+// iteration is over; Err should then be checked.
+func (it *WalletIterator) Next() bool {
+	for len(it.items) == 0 {
+		if !it.nextPage() {
+			return false
+		}
+		it.items, it.page = it.page, nil
+	}
+	if !it.take() {
+		return false
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Wallet returns the current wallet.
+func (it *WalletIterator) Wallet() *Wallet {
+	return it.cur
+}
+
+// ListAll returns all the wallets matching opt, walking every page.
+func (s *WalletService) ListAll(ctx context.Context, opt *WalletListOptions) ([]*Wallet, error) {
+	var all []*Wallet
+	it := s.Iterate(ctx, opt)
+	for it.Next() {
+		all = append(all, it.Wallet())
+	}
+	return all, it.Err()
+}
@@ -0,0 +1,16 @@
+package treezor
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+)
+
+// newTestClient returns a client sending its requests to handler.
+func newTestClient(handler http.HandlerFunc) (*Client, func()) {
+	server := httptest.NewServer(handler)
+	client := NewClient(nil, false)
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	client.BaseURLWithoutIndex, _ = url.Parse(server.URL + "/")
+	return client, server.Close
+}
@@ -0,0 +1,60 @@
+// Package treezormock provides mocks of the services of the Treezor client,
+// generated from the interfaces of the treezor package.
+//
+// Example usage:
+//
+//	users := &treezormock.UserAPI{}
+//	users.GetFunc = func(ctx context.Context, userID string) (*treezor.User, *http.Response, error) {
+//		return &treezor.User{UserID: treezor.String(userID)}, nil, nil
+//	}
+//	svc := NewSignupService(users) // Accepts a treezor.UserAPI.
+//	...
+//	calls := users.CallsTo("Get")
+package treezormock
+
+import "sync"
+
+// Call is a recorded call to a mock method.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Mock records the calls made to a mock. It is safe for concurrent use.
+type Mock struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (m *Mock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls, oldest first.
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the recorded calls to method, oldest first.
+func (m *Mock) CallsTo(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var calls []Call
+	for _, c := range m.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (m *Mock) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
@@ -0,0 +1,54 @@
+package treezormock
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+// activate is code under test depending on a treezor.CardAPI.
+func activate(ctx context.Context, cards treezor.CardAPI, cardID string) (string, error) {
+	card, _, err := cards.Activate(ctx, cardID)
+	if err != nil {
+		return "", err
+	}
+	return card.GetStatusCode(), nil
+}
+
+func TestCardAPI(t *testing.T) {
+	ctx := context.Background()
+	cards := &CardAPI{}
+
+	t.Run("Zero values", func(t *testing.T) {
+		card, resp, err := cards.Get(ctx, "1")
+		assert.Nil(t, card)
+		assert.Nil(t, resp)
+		assert.Nil(t, err)
+	})
+	t.Run("Func", func(t *testing.T) {
+		cards.ActivateFunc = func(ctx context.Context, cardID string) (*treezor.Card, *http.Response, error) {
+			if cardID == "lost" {
+				return nil, nil, treezor.ErrCardLost
+			}
+			return &treezor.Card{CardID: treezor.String(cardID), StatusCode: treezor.String("UNLOCK")}, nil, nil
+		}
+		status, err := activate(ctx, cards, "42")
+		assert.Nil(t, err)
+		assert.Equal(t, "UNLOCK", status)
+		_, err = activate(ctx, cards, "lost")
+		assert.True(t, errors.Is(err, treezor.ErrCardLost))
+	})
+	t.Run("Calls", func(t *testing.T) {
+		assert.Len(t, cards.Calls(), 3)
+		assert.Equal(t, []Call{
+			{Method: "Activate", Args: []interface{}{ctx, "42"}},
+			{Method: "Activate", Args: []interface{}{ctx, "lost"}},
+		}, cards.CallsTo("Activate"))
+		cards.Reset()
+		assert.Empty(t, cards.Calls())
+	})
+}
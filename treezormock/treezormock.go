@@ -0,0 +1,1361 @@
+// Code generated by gen_mocks; DO NOT EDIT.
+
+package treezormock
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+// BalanceAPI is a mock of treezor.BalanceAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type BalanceAPI struct {
+	Mock
+
+	ListFunc    func(ctx context.Context, opt *treezor.BalanceOptions) (*treezor.BalanceResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.BalanceOptions) ([]*treezor.Balance, error)
+}
+
+var _ treezor.BalanceAPI = (*BalanceAPI)(nil)
+
+// List records the call and calls ListFunc.
+func (m *BalanceAPI) List(ctx context.Context, opt *treezor.BalanceOptions) (r0 *treezor.BalanceResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *BalanceAPI) ListAll(ctx context.Context, opt *treezor.BalanceOptions) (r0 []*treezor.Balance, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// BankAccountAPI is a mock of treezor.BankAccountAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type BankAccountAPI struct {
+	Mock
+
+	CancelFunc  func(ctx context.Context, bankAccountID string) (*treezor.BankAccount, *http.Response, error)
+	CreateFunc  func(ctx context.Context, bankAccount *treezor.BankAccount) (*treezor.BankAccount, *http.Response, error)
+	GetFunc     func(ctx context.Context, bankAccountID string) (*treezor.BankAccount, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.BankAccountListOptions) (*treezor.BankAccountResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.BankAccountListOptions) ([]*treezor.BankAccount, error)
+}
+
+var _ treezor.BankAccountAPI = (*BankAccountAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *BankAccountAPI) Cancel(ctx context.Context, bankAccountID string) (r0 *treezor.BankAccount, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, bankAccountID)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, bankAccountID)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *BankAccountAPI) Create(ctx context.Context, bankAccount *treezor.BankAccount) (r0 *treezor.BankAccount, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, bankAccount)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, bankAccount)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *BankAccountAPI) Get(ctx context.Context, bankAccountID string) (r0 *treezor.BankAccount, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, bankAccountID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, bankAccountID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *BankAccountAPI) List(ctx context.Context, opt *treezor.BankAccountListOptions) (r0 *treezor.BankAccountResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *BankAccountAPI) ListAll(ctx context.Context, opt *treezor.BankAccountListOptions) (r0 []*treezor.BankAccount, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// BeneficiaryAPI is a mock of treezor.BeneficiaryAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type BeneficiaryAPI struct {
+	Mock
+
+	CreateFunc  func(ctx context.Context, beneficiary *treezor.BeneficiaryRequest) (*treezor.Beneficiary, *http.Response, error)
+	EditFunc    func(ctx context.Context, beneficiaryID string, beneficiary *treezor.BeneficiaryRequest) (*treezor.Beneficiary, *http.Response, error)
+	GetFunc     func(ctx context.Context, beneficiaryID string) (*treezor.Beneficiary, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.BeneficiaryOptions) (*treezor.BeneficiaryResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.BeneficiaryOptions) ([]*treezor.Beneficiary, error)
+}
+
+var _ treezor.BeneficiaryAPI = (*BeneficiaryAPI)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *BeneficiaryAPI) Create(ctx context.Context, beneficiary *treezor.BeneficiaryRequest) (r0 *treezor.Beneficiary, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, beneficiary)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, beneficiary)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc.
+func (m *BeneficiaryAPI) Edit(ctx context.Context, beneficiaryID string, beneficiary *treezor.BeneficiaryRequest) (r0 *treezor.Beneficiary, r1 *http.Response, r2 error) {
+	m.record("Edit", ctx, beneficiaryID, beneficiary)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, beneficiaryID, beneficiary)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *BeneficiaryAPI) Get(ctx context.Context, beneficiaryID string) (r0 *treezor.Beneficiary, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, beneficiaryID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, beneficiaryID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *BeneficiaryAPI) List(ctx context.Context, opt *treezor.BeneficiaryOptions) (r0 *treezor.BeneficiaryResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *BeneficiaryAPI) ListAll(ctx context.Context, opt *treezor.BeneficiaryOptions) (r0 []*treezor.Beneficiary, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// CardAPI is a mock of treezor.CardAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type CardAPI struct {
+	Mock
+
+	ActivateFunc              func(ctx context.Context, cardID string) (*treezor.Card, *http.Response, error)
+	AttachCountryGroupFunc    func(ctx context.Context, cardID string, groupID int64) (*treezor.Card, *http.Response, error)
+	AttachMCCGroupFunc        func(ctx context.Context, cardID string, groupID int64) (*treezor.Card, *http.Response, error)
+	AttachMerchantIDGroupFunc func(ctx context.Context, cardID string, groupID int64) (*treezor.Card, *http.Response, error)
+	ChangeLimitsFunc          func(ctx context.Context, cardID string, limits *treezor.CardLimits) (*treezor.Card, *http.Response, error)
+	ChangeOptionsFunc         func(ctx context.Context, cardID string, options *treezor.CardOptions) (*treezor.Card, *http.Response, error)
+	ChangePINFunc             func(ctx context.Context, cardID string, pin *treezor.PIN) (*treezor.Card, *http.Response, error)
+	ConvertVirtualFunc        func(ctx context.Context, cardID string) (*treezor.Card, *http.Response, error)
+	CreateVirtualFunc         func(ctx context.Context, card *treezor.Card) (*treezor.Card, *http.Response, error)
+	DeactivateFunc            func(ctx context.Context, cardID string) (*treezor.Card, *http.Response, error)
+	EditFunc                  func(ctx context.Context, cardID string, card *treezor.Card) (*treezor.Card, *http.Response, error)
+	GetFunc                   func(ctx context.Context, cardID string) (*treezor.Card, *http.Response, error)
+	GetImageFunc              func(ctx context.Context, opt *treezor.CardGetImagesOptions) (*treezor.CardImage, *http.Response, error)
+	GetManyFunc               func(ctx context.Context, cardIDs []string, opt *treezor.GetManyOptions) ([]*treezor.Card, error)
+	ListFunc                  func(ctx context.Context, opt *treezor.CardListOptions) (*treezor.CardResponse, *http.Response, error)
+	ListAllFunc               func(ctx context.Context, opt *treezor.CardListOptions) ([]*treezor.Card, error)
+	LockUnlockFunc            func(ctx context.Context, cardID string, lockStatus treezor.LockStatus) (*treezor.Card, *http.Response, error)
+	RegenerateFunc            func(ctx context.Context, cardID string) (*treezor.Card, *http.Response, error)
+	Register3DSecureFunc      func(ctx context.Context, cardID *treezor.Card3DS) (*treezor.Card, *http.Response, error)
+	RequestPhysicalFunc       func(ctx context.Context, card *treezor.Card) (*treezor.Card, *http.Response, error)
+	SetPINFunc                func(ctx context.Context, cardID string, pin *treezor.PIN) (*treezor.Card, *http.Response, error)
+	UnblockPINFunc            func(ctx context.Context, cardID string) (*treezor.Card, *http.Response, error)
+}
+
+var _ treezor.CardAPI = (*CardAPI)(nil)
+
+// Activate records the call and calls ActivateFunc.
+func (m *CardAPI) Activate(ctx context.Context, cardID string) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("Activate", ctx, cardID)
+	if m.ActivateFunc != nil {
+		return m.ActivateFunc(ctx, cardID)
+	}
+	return
+}
+
+// AttachCountryGroup records the call and calls AttachCountryGroupFunc.
+func (m *CardAPI) AttachCountryGroup(ctx context.Context, cardID string, groupID int64) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("AttachCountryGroup", ctx, cardID, groupID)
+	if m.AttachCountryGroupFunc != nil {
+		return m.AttachCountryGroupFunc(ctx, cardID, groupID)
+	}
+	return
+}
+
+// AttachMCCGroup records the call and calls AttachMCCGroupFunc.
+func (m *CardAPI) AttachMCCGroup(ctx context.Context, cardID string, groupID int64) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("AttachMCCGroup", ctx, cardID, groupID)
+	if m.AttachMCCGroupFunc != nil {
+		return m.AttachMCCGroupFunc(ctx, cardID, groupID)
+	}
+	return
+}
+
+// AttachMerchantIDGroup records the call and calls AttachMerchantIDGroupFunc.
+func (m *CardAPI) AttachMerchantIDGroup(ctx context.Context, cardID string, groupID int64) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("AttachMerchantIDGroup", ctx, cardID, groupID)
+	if m.AttachMerchantIDGroupFunc != nil {
+		return m.AttachMerchantIDGroupFunc(ctx, cardID, groupID)
+	}
+	return
+}
+
+// ChangeLimits records the call and calls ChangeLimitsFunc.
+func (m *CardAPI) ChangeLimits(ctx context.Context, cardID string, limits *treezor.CardLimits) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("ChangeLimits", ctx, cardID, limits)
+	if m.ChangeLimitsFunc != nil {
+		return m.ChangeLimitsFunc(ctx, cardID, limits)
+	}
+	return
+}
+
+// ChangeOptions records the call and calls ChangeOptionsFunc.
+func (m *CardAPI) ChangeOptions(ctx context.Context, cardID string, options *treezor.CardOptions) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("ChangeOptions", ctx, cardID, options)
+	if m.ChangeOptionsFunc != nil {
+		return m.ChangeOptionsFunc(ctx, cardID, options)
+	}
+	return
+}
+
+// ChangePIN records the call and calls ChangePINFunc.
+func (m *CardAPI) ChangePIN(ctx context.Context, cardID string, pin *treezor.PIN) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("ChangePIN", ctx, cardID, pin)
+	if m.ChangePINFunc != nil {
+		return m.ChangePINFunc(ctx, cardID, pin)
+	}
+	return
+}
+
+// ConvertVirtual records the call and calls ConvertVirtualFunc.
+func (m *CardAPI) ConvertVirtual(ctx context.Context, cardID string) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("ConvertVirtual", ctx, cardID)
+	if m.ConvertVirtualFunc != nil {
+		return m.ConvertVirtualFunc(ctx, cardID)
+	}
+	return
+}
+
+// CreateVirtual records the call and calls CreateVirtualFunc.
+func (m *CardAPI) CreateVirtual(ctx context.Context, card *treezor.Card) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("CreateVirtual", ctx, card)
+	if m.CreateVirtualFunc != nil {
+		return m.CreateVirtualFunc(ctx, card)
+	}
+	return
+}
+
+// Deactivate records the call and calls DeactivateFunc.
+func (m *CardAPI) Deactivate(ctx context.Context, cardID string) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("Deactivate", ctx, cardID)
+	if m.DeactivateFunc != nil {
+		return m.DeactivateFunc(ctx, cardID)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc.
+func (m *CardAPI) Edit(ctx context.Context, cardID string, card *treezor.Card) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("Edit", ctx, cardID, card)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, cardID, card)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *CardAPI) Get(ctx context.Context, cardID string) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, cardID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, cardID)
+	}
+	return
+}
+
+// GetImage records the call and calls GetImageFunc.
+func (m *CardAPI) GetImage(ctx context.Context, opt *treezor.CardGetImagesOptions) (r0 *treezor.CardImage, r1 *http.Response, r2 error) {
+	m.record("GetImage", ctx, opt)
+	if m.GetImageFunc != nil {
+		return m.GetImageFunc(ctx, opt)
+	}
+	return
+}
+
+// GetMany records the call and calls GetManyFunc.
+func (m *CardAPI) GetMany(ctx context.Context, cardIDs []string, opt *treezor.GetManyOptions) (r0 []*treezor.Card, r1 error) {
+	m.record("GetMany", ctx, cardIDs, opt)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(ctx, cardIDs, opt)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *CardAPI) List(ctx context.Context, opt *treezor.CardListOptions) (r0 *treezor.CardResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *CardAPI) ListAll(ctx context.Context, opt *treezor.CardListOptions) (r0 []*treezor.Card, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// LockUnlock records the call and calls LockUnlockFunc.
+func (m *CardAPI) LockUnlock(ctx context.Context, cardID string, lockStatus treezor.LockStatus) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("LockUnlock", ctx, cardID, lockStatus)
+	if m.LockUnlockFunc != nil {
+		return m.LockUnlockFunc(ctx, cardID, lockStatus)
+	}
+	return
+}
+
+// Regenerate records the call and calls RegenerateFunc.
+func (m *CardAPI) Regenerate(ctx context.Context, cardID string) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("Regenerate", ctx, cardID)
+	if m.RegenerateFunc != nil {
+		return m.RegenerateFunc(ctx, cardID)
+	}
+	return
+}
+
+// Register3DSecure records the call and calls Register3DSecureFunc.
+func (m *CardAPI) Register3DSecure(ctx context.Context, cardID *treezor.Card3DS) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("Register3DSecure", ctx, cardID)
+	if m.Register3DSecureFunc != nil {
+		return m.Register3DSecureFunc(ctx, cardID)
+	}
+	return
+}
+
+// RequestPhysical records the call and calls RequestPhysicalFunc.
+func (m *CardAPI) RequestPhysical(ctx context.Context, card *treezor.Card) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("RequestPhysical", ctx, card)
+	if m.RequestPhysicalFunc != nil {
+		return m.RequestPhysicalFunc(ctx, card)
+	}
+	return
+}
+
+// SetPIN records the call and calls SetPINFunc.
+func (m *CardAPI) SetPIN(ctx context.Context, cardID string, pin *treezor.PIN) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("SetPIN", ctx, cardID, pin)
+	if m.SetPINFunc != nil {
+		return m.SetPINFunc(ctx, cardID, pin)
+	}
+	return
+}
+
+// UnblockPIN records the call and calls UnblockPINFunc.
+func (m *CardAPI) UnblockPIN(ctx context.Context, cardID string) (r0 *treezor.Card, r1 *http.Response, r2 error) {
+	m.record("UnblockPIN", ctx, cardID)
+	if m.UnblockPINFunc != nil {
+		return m.UnblockPINFunc(ctx, cardID)
+	}
+	return
+}
+
+// CardTransactionAPI is a mock of treezor.CardTransactionAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type CardTransactionAPI struct {
+	Mock
+
+	GetFunc     func(ctx context.Context, cardTransactionID string) (*treezor.CardTransaction, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.CardTransactionsListOptions) (*treezor.CardTransactionResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.CardTransactionsListOptions) ([]*treezor.CardTransaction, error)
+}
+
+var _ treezor.CardTransactionAPI = (*CardTransactionAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *CardTransactionAPI) Get(ctx context.Context, cardTransactionID string) (r0 *treezor.CardTransaction, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, cardTransactionID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, cardTransactionID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *CardTransactionAPI) List(ctx context.Context, opt *treezor.CardTransactionsListOptions) (r0 *treezor.CardTransactionResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *CardTransactionAPI) ListAll(ctx context.Context, opt *treezor.CardTransactionsListOptions) (r0 []*treezor.CardTransaction, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// CountryGroupAPI is a mock of treezor.CountryGroupAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type CountryGroupAPI struct {
+	Mock
+
+	CancelFunc  func(ctx context.Context, groupID int64) (*treezor.CountryGroup, *http.Response, error)
+	CreateFunc  func(ctx context.Context, group *treezor.CountryGroup) (*treezor.CountryGroup, *http.Response, error)
+	EditFunc    func(ctx context.Context, groupID int64, group *treezor.CountryGroup) (*treezor.CountryGroup, *http.Response, error)
+	GetFunc     func(ctx context.Context, groupID int64) (*treezor.CountryGroup, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.CountryGroupListOptions) (*treezor.CountryGroupResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.CountryGroupListOptions) ([]*treezor.CountryGroup, error)
+}
+
+var _ treezor.CountryGroupAPI = (*CountryGroupAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *CountryGroupAPI) Cancel(ctx context.Context, groupID int64) (r0 *treezor.CountryGroup, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, groupID)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, groupID)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *CountryGroupAPI) Create(ctx context.Context, group *treezor.CountryGroup) (r0 *treezor.CountryGroup, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, group)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, group)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc.
+func (m *CountryGroupAPI) Edit(ctx context.Context, groupID int64, group *treezor.CountryGroup) (r0 *treezor.CountryGroup, r1 *http.Response, r2 error) {
+	m.record("Edit", ctx, groupID, group)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, groupID, group)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *CountryGroupAPI) Get(ctx context.Context, groupID int64) (r0 *treezor.CountryGroup, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, groupID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, groupID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *CountryGroupAPI) List(ctx context.Context, opt *treezor.CountryGroupListOptions) (r0 *treezor.CountryGroupResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *CountryGroupAPI) ListAll(ctx context.Context, opt *treezor.CountryGroupListOptions) (r0 []*treezor.CountryGroup, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// DocumentAPI is a mock of treezor.DocumentAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type DocumentAPI struct {
+	Mock
+
+	DeleteFunc func(ctx context.Context, documentID string) (*http.Response, error)
+	GetFunc    func(ctx context.Context, documentID string) (*treezor.Document, *http.Response, error)
+	SendFunc   func(ctx context.Context, document *treezor.Document) (*treezor.Document, *http.Response, error)
+}
+
+var _ treezor.DocumentAPI = (*DocumentAPI)(nil)
+
+// Delete records the call and calls DeleteFunc.
+func (m *DocumentAPI) Delete(ctx context.Context, documentID string) (r0 *http.Response, r1 error) {
+	m.record("Delete", ctx, documentID)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, documentID)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *DocumentAPI) Get(ctx context.Context, documentID string) (r0 *treezor.Document, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, documentID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, documentID)
+	}
+	return
+}
+
+// Send records the call and calls SendFunc.
+func (m *DocumentAPI) Send(ctx context.Context, document *treezor.Document) (r0 *treezor.Document, r1 *http.Response, r2 error) {
+	m.record("Send", ctx, document)
+	if m.SendFunc != nil {
+		return m.SendFunc(ctx, document)
+	}
+	return
+}
+
+// HearthbeatAPI is a mock of treezor.HearthbeatAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type HearthbeatAPI struct {
+	Mock
+
+	PingFunc func(ctx context.Context) (bool, *http.Response, error)
+}
+
+var _ treezor.HearthbeatAPI = (*HearthbeatAPI)(nil)
+
+// Ping records the call and calls PingFunc.
+func (m *HearthbeatAPI) Ping(ctx context.Context) (r0 bool, r1 *http.Response, r2 error) {
+	m.record("Ping", ctx)
+	if m.PingFunc != nil {
+		return m.PingFunc(ctx)
+	}
+	return
+}
+
+// MCCGroupAPI is a mock of treezor.MCCGroupAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type MCCGroupAPI struct {
+	Mock
+
+	CancelFunc  func(ctx context.Context, groupID int64) (*treezor.MCCGroup, *http.Response, error)
+	CreateFunc  func(ctx context.Context, group *treezor.MCCGroup) (*treezor.MCCGroup, *http.Response, error)
+	EditFunc    func(ctx context.Context, groupID int64, group *treezor.MCCGroup) (*treezor.MCCGroup, *http.Response, error)
+	GetFunc     func(ctx context.Context, groupID int64) (*treezor.MCCGroup, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.MCCGroupListOptions) (*treezor.MCCGroupResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.MCCGroupListOptions) ([]*treezor.MCCGroup, error)
+}
+
+var _ treezor.MCCGroupAPI = (*MCCGroupAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *MCCGroupAPI) Cancel(ctx context.Context, groupID int64) (r0 *treezor.MCCGroup, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, groupID)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, groupID)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *MCCGroupAPI) Create(ctx context.Context, group *treezor.MCCGroup) (r0 *treezor.MCCGroup, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, group)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, group)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc.
+func (m *MCCGroupAPI) Edit(ctx context.Context, groupID int64, group *treezor.MCCGroup) (r0 *treezor.MCCGroup, r1 *http.Response, r2 error) {
+	m.record("Edit", ctx, groupID, group)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, groupID, group)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *MCCGroupAPI) Get(ctx context.Context, groupID int64) (r0 *treezor.MCCGroup, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, groupID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, groupID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *MCCGroupAPI) List(ctx context.Context, opt *treezor.MCCGroupListOptions) (r0 *treezor.MCCGroupResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *MCCGroupAPI) ListAll(ctx context.Context, opt *treezor.MCCGroupListOptions) (r0 []*treezor.MCCGroup, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// MandateAPI is a mock of treezor.MandateAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type MandateAPI struct {
+	Mock
+
+	CancelFunc              func(ctx context.Context, mandateID string, opt *treezor.MandateCancelOptions) (*treezor.Mandate, *http.Response, error)
+	CreateFunc              func(ctx context.Context, mandate *treezor.Mandate) (*treezor.Mandate, *http.Response, error)
+	GetFunc                 func(ctx context.Context, mandateID string) (*treezor.Mandate, *http.Response, error)
+	GetPDFFunc              func(ctx context.Context, mandateID string, w io.Writer) (*http.Response, error)
+	ListFunc                func(ctx context.Context, opt *treezor.MandateListOptions) (*treezor.MandateResponse, *http.Response, error)
+	ListAllFunc             func(ctx context.Context, opt *treezor.MandateListOptions) ([]*treezor.Mandate, error)
+	RequestSignatureOTPFunc func(ctx context.Context, mandateID string) (*treezor.Mandate, *http.Response, error)
+	SignFunc                func(ctx context.Context, mandateID string, signature *treezor.MandateSignature) (*treezor.Mandate, *http.Response, error)
+}
+
+var _ treezor.MandateAPI = (*MandateAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *MandateAPI) Cancel(ctx context.Context, mandateID string, opt *treezor.MandateCancelOptions) (r0 *treezor.Mandate, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, mandateID, opt)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, mandateID, opt)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *MandateAPI) Create(ctx context.Context, mandate *treezor.Mandate) (r0 *treezor.Mandate, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, mandate)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, mandate)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *MandateAPI) Get(ctx context.Context, mandateID string) (r0 *treezor.Mandate, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, mandateID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, mandateID)
+	}
+	return
+}
+
+// GetPDF records the call and calls GetPDFFunc.
+func (m *MandateAPI) GetPDF(ctx context.Context, mandateID string, w io.Writer) (r0 *http.Response, r1 error) {
+	m.record("GetPDF", ctx, mandateID, w)
+	if m.GetPDFFunc != nil {
+		return m.GetPDFFunc(ctx, mandateID, w)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *MandateAPI) List(ctx context.Context, opt *treezor.MandateListOptions) (r0 *treezor.MandateResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *MandateAPI) ListAll(ctx context.Context, opt *treezor.MandateListOptions) (r0 []*treezor.Mandate, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// RequestSignatureOTP records the call and calls RequestSignatureOTPFunc.
+func (m *MandateAPI) RequestSignatureOTP(ctx context.Context, mandateID string) (r0 *treezor.Mandate, r1 *http.Response, r2 error) {
+	m.record("RequestSignatureOTP", ctx, mandateID)
+	if m.RequestSignatureOTPFunc != nil {
+		return m.RequestSignatureOTPFunc(ctx, mandateID)
+	}
+	return
+}
+
+// Sign records the call and calls SignFunc.
+func (m *MandateAPI) Sign(ctx context.Context, mandateID string, signature *treezor.MandateSignature) (r0 *treezor.Mandate, r1 *http.Response, r2 error) {
+	m.record("Sign", ctx, mandateID, signature)
+	if m.SignFunc != nil {
+		return m.SignFunc(ctx, mandateID, signature)
+	}
+	return
+}
+
+// MerchantIDGroupAPI is a mock of treezor.MerchantIDGroupAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type MerchantIDGroupAPI struct {
+	Mock
+
+	CancelFunc  func(ctx context.Context, groupID int64) (*treezor.MerchantIDGroup, *http.Response, error)
+	CreateFunc  func(ctx context.Context, group *treezor.MerchantIDGroup) (*treezor.MerchantIDGroup, *http.Response, error)
+	EditFunc    func(ctx context.Context, groupID int64, group *treezor.MerchantIDGroup) (*treezor.MerchantIDGroup, *http.Response, error)
+	GetFunc     func(ctx context.Context, groupID int64) (*treezor.MerchantIDGroup, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.MerchantIDGroupListOptions) (*treezor.MerchantIDGroupResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.MerchantIDGroupListOptions) ([]*treezor.MerchantIDGroup, error)
+}
+
+var _ treezor.MerchantIDGroupAPI = (*MerchantIDGroupAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *MerchantIDGroupAPI) Cancel(ctx context.Context, groupID int64) (r0 *treezor.MerchantIDGroup, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, groupID)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, groupID)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *MerchantIDGroupAPI) Create(ctx context.Context, group *treezor.MerchantIDGroup) (r0 *treezor.MerchantIDGroup, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, group)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, group)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc.
+func (m *MerchantIDGroupAPI) Edit(ctx context.Context, groupID int64, group *treezor.MerchantIDGroup) (r0 *treezor.MerchantIDGroup, r1 *http.Response, r2 error) {
+	m.record("Edit", ctx, groupID, group)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, groupID, group)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *MerchantIDGroupAPI) Get(ctx context.Context, groupID int64) (r0 *treezor.MerchantIDGroup, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, groupID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, groupID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *MerchantIDGroupAPI) List(ctx context.Context, opt *treezor.MerchantIDGroupListOptions) (r0 *treezor.MerchantIDGroupResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *MerchantIDGroupAPI) ListAll(ctx context.Context, opt *treezor.MerchantIDGroupListOptions) (r0 []*treezor.MerchantIDGroup, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// PayinRefundAPI is a mock of treezor.PayinRefundAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type PayinRefundAPI struct {
+	Mock
+
+	CancelFunc  func(ctx context.Context, payinRefundID string) (*treezor.PayinRefund, *http.Response, error)
+	CreateFunc  func(ctx context.Context, refund *treezor.PayinRefund) (*treezor.PayinRefund, *http.Response, error)
+	GetFunc     func(ctx context.Context, payinRefundID string) (*treezor.PayinRefund, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.PayinRefundListOptions) (*treezor.PayinRefundResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.PayinRefundListOptions) ([]*treezor.PayinRefund, error)
+}
+
+var _ treezor.PayinRefundAPI = (*PayinRefundAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *PayinRefundAPI) Cancel(ctx context.Context, payinRefundID string) (r0 *treezor.PayinRefund, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, payinRefundID)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, payinRefundID)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *PayinRefundAPI) Create(ctx context.Context, refund *treezor.PayinRefund) (r0 *treezor.PayinRefund, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, refund)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, refund)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *PayinRefundAPI) Get(ctx context.Context, payinRefundID string) (r0 *treezor.PayinRefund, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, payinRefundID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, payinRefundID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *PayinRefundAPI) List(ctx context.Context, opt *treezor.PayinRefundListOptions) (r0 *treezor.PayinRefundResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *PayinRefundAPI) ListAll(ctx context.Context, opt *treezor.PayinRefundListOptions) (r0 []*treezor.PayinRefund, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// PayinAPI is a mock of treezor.PayinAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type PayinAPI struct {
+	Mock
+
+	CreateFunc  func(ctx context.Context, payin *treezor.Payin) (*treezor.Payin, *http.Response, error)
+	DeleteFunc  func(ctx context.Context, payinID string) (*treezor.Payin, *http.Response, error)
+	GetFunc     func(ctx context.Context, payinID string) (*treezor.Payin, *http.Response, error)
+	GetManyFunc func(ctx context.Context, payinIDs []string, opt *treezor.GetManyOptions) ([]*treezor.Payin, error)
+	ListFunc    func(ctx context.Context, opt *treezor.PayinListOptions) (*treezor.PayinResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.PayinListOptions) ([]*treezor.Payin, error)
+}
+
+var _ treezor.PayinAPI = (*PayinAPI)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *PayinAPI) Create(ctx context.Context, payin *treezor.Payin) (r0 *treezor.Payin, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, payin)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, payin)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *PayinAPI) Delete(ctx context.Context, payinID string) (r0 *treezor.Payin, r1 *http.Response, r2 error) {
+	m.record("Delete", ctx, payinID)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, payinID)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *PayinAPI) Get(ctx context.Context, payinID string) (r0 *treezor.Payin, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, payinID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, payinID)
+	}
+	return
+}
+
+// GetMany records the call and calls GetManyFunc.
+func (m *PayinAPI) GetMany(ctx context.Context, payinIDs []string, opt *treezor.GetManyOptions) (r0 []*treezor.Payin, r1 error) {
+	m.record("GetMany", ctx, payinIDs, opt)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(ctx, payinIDs, opt)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *PayinAPI) List(ctx context.Context, opt *treezor.PayinListOptions) (r0 *treezor.PayinResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *PayinAPI) ListAll(ctx context.Context, opt *treezor.PayinListOptions) (r0 []*treezor.Payin, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// PayoutAPI is a mock of treezor.PayoutAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type PayoutAPI struct {
+	Mock
+
+	CreateFunc  func(ctx context.Context, payout *treezor.Payout) (*treezor.Payout, *http.Response, error)
+	DeleteFunc  func(ctx context.Context, payoutID string) (*treezor.Payout, *http.Response, error)
+	GetFunc     func(ctx context.Context, payoutID string) (*treezor.Payout, *http.Response, error)
+	GetManyFunc func(ctx context.Context, payoutIDs []string, opt *treezor.GetManyOptions) ([]*treezor.Payout, error)
+	ListFunc    func(ctx context.Context, opt *treezor.PayoutListOptions) (*treezor.PayoutResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.PayoutListOptions) ([]*treezor.Payout, error)
+}
+
+var _ treezor.PayoutAPI = (*PayoutAPI)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *PayoutAPI) Create(ctx context.Context, payout *treezor.Payout) (r0 *treezor.Payout, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, payout)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, payout)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *PayoutAPI) Delete(ctx context.Context, payoutID string) (r0 *treezor.Payout, r1 *http.Response, r2 error) {
+	m.record("Delete", ctx, payoutID)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, payoutID)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *PayoutAPI) Get(ctx context.Context, payoutID string) (r0 *treezor.Payout, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, payoutID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, payoutID)
+	}
+	return
+}
+
+// GetMany records the call and calls GetManyFunc.
+func (m *PayoutAPI) GetMany(ctx context.Context, payoutIDs []string, opt *treezor.GetManyOptions) (r0 []*treezor.Payout, r1 error) {
+	m.record("GetMany", ctx, payoutIDs, opt)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(ctx, payoutIDs, opt)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *PayoutAPI) List(ctx context.Context, opt *treezor.PayoutListOptions) (r0 *treezor.PayoutResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *PayoutAPI) ListAll(ctx context.Context, opt *treezor.PayoutListOptions) (r0 []*treezor.Payout, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// TaxResidencesAPI is a mock of treezor.TaxResidencesAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type TaxResidencesAPI struct {
+	Mock
+
+	CreateFunc func(ctx context.Context, taxResidence *treezor.TaxResidence) (*treezor.TaxResidence, *http.Response, error)
+	EditFunc   func(ctx context.Context, taxResidenceID int64, taxResidence *treezor.TaxResidence) (*treezor.TaxResidence, *http.Response, error)
+}
+
+var _ treezor.TaxResidencesAPI = (*TaxResidencesAPI)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *TaxResidencesAPI) Create(ctx context.Context, taxResidence *treezor.TaxResidence) (r0 *treezor.TaxResidence, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, taxResidence)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, taxResidence)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc.
+func (m *TaxResidencesAPI) Edit(ctx context.Context, taxResidenceID int64, taxResidence *treezor.TaxResidence) (r0 *treezor.TaxResidence, r1 *http.Response, r2 error) {
+	m.record("Edit", ctx, taxResidenceID, taxResidence)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, taxResidenceID, taxResidence)
+	}
+	return
+}
+
+// TransactionAPI is a mock of treezor.TransactionAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type TransactionAPI struct {
+	Mock
+
+	GetFunc     func(ctx context.Context, transactionID string) (*treezor.Transaction, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.TransactionListOptions) (*treezor.TransactionResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.TransactionListOptions) ([]*treezor.Transaction, error)
+}
+
+var _ treezor.TransactionAPI = (*TransactionAPI)(nil)
+
+// Get records the call and calls GetFunc.
+func (m *TransactionAPI) Get(ctx context.Context, transactionID string) (r0 *treezor.Transaction, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, transactionID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, transactionID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *TransactionAPI) List(ctx context.Context, opt *treezor.TransactionListOptions) (r0 *treezor.TransactionResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *TransactionAPI) ListAll(ctx context.Context, opt *treezor.TransactionListOptions) (r0 []*treezor.Transaction, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// TransferRefundAPI is a mock of treezor.TransferRefundAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type TransferRefundAPI struct {
+	Mock
+
+	CancelFunc  func(ctx context.Context, transferRefundID string) (*treezor.TransferRefund, *http.Response, error)
+	CreateFunc  func(ctx context.Context, refund *treezor.TransferRefund) (*treezor.TransferRefund, *http.Response, error)
+	GetFunc     func(ctx context.Context, transferRefundID string) (*treezor.TransferRefund, *http.Response, error)
+	ListFunc    func(ctx context.Context, opt *treezor.TransferRefundListOptions) (*treezor.TransferRefundResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.TransferRefundListOptions) ([]*treezor.TransferRefund, error)
+}
+
+var _ treezor.TransferRefundAPI = (*TransferRefundAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *TransferRefundAPI) Cancel(ctx context.Context, transferRefundID string) (r0 *treezor.TransferRefund, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, transferRefundID)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, transferRefundID)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *TransferRefundAPI) Create(ctx context.Context, refund *treezor.TransferRefund) (r0 *treezor.TransferRefund, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, refund)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, refund)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *TransferRefundAPI) Get(ctx context.Context, transferRefundID string) (r0 *treezor.TransferRefund, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, transferRefundID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, transferRefundID)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *TransferRefundAPI) List(ctx context.Context, opt *treezor.TransferRefundListOptions) (r0 *treezor.TransferRefundResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *TransferRefundAPI) ListAll(ctx context.Context, opt *treezor.TransferRefundListOptions) (r0 []*treezor.TransferRefund, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// TransferAPI is a mock of treezor.TransferAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type TransferAPI struct {
+	Mock
+
+	CreateFunc  func(ctx context.Context, transfer *treezor.Transfer) (*treezor.Transfer, *http.Response, error)
+	DeleteFunc  func(ctx context.Context, transferID string) (*treezor.Transfer, *http.Response, error)
+	GetFunc     func(ctx context.Context, transferID string) (*treezor.Transfer, *http.Response, error)
+	GetManyFunc func(ctx context.Context, transferIDs []string, opt *treezor.GetManyOptions) ([]*treezor.Transfer, error)
+	ListFunc    func(ctx context.Context, opt *treezor.TransferListOptions) (*treezor.TransferResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.TransferListOptions) ([]*treezor.Transfer, error)
+}
+
+var _ treezor.TransferAPI = (*TransferAPI)(nil)
+
+// Create records the call and calls CreateFunc.
+func (m *TransferAPI) Create(ctx context.Context, transfer *treezor.Transfer) (r0 *treezor.Transfer, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, transfer)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, transfer)
+	}
+	return
+}
+
+// Delete records the call and calls DeleteFunc.
+func (m *TransferAPI) Delete(ctx context.Context, transferID string) (r0 *treezor.Transfer, r1 *http.Response, r2 error) {
+	m.record("Delete", ctx, transferID)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, transferID)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *TransferAPI) Get(ctx context.Context, transferID string) (r0 *treezor.Transfer, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, transferID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, transferID)
+	}
+	return
+}
+
+// GetMany records the call and calls GetManyFunc.
+func (m *TransferAPI) GetMany(ctx context.Context, transferIDs []string, opt *treezor.GetManyOptions) (r0 []*treezor.Transfer, r1 error) {
+	m.record("GetMany", ctx, transferIDs, opt)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(ctx, transferIDs, opt)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *TransferAPI) List(ctx context.Context, opt *treezor.TransferListOptions) (r0 *treezor.TransferResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *TransferAPI) ListAll(ctx context.Context, opt *treezor.TransferListOptions) (r0 []*treezor.Transfer, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// UserAPI is a mock of treezor.UserAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type UserAPI struct {
+	Mock
+
+	CancelFunc             func(ctx context.Context, userID string, opt *treezor.UserCancelOptions) (*treezor.User, *http.Response, error)
+	CreateFunc             func(ctx context.Context, user *treezor.User) (*treezor.User, *http.Response, error)
+	EditFunc               func(ctx context.Context, userID string, user *treezor.User) (*treezor.User, *http.Response, error)
+	GetFunc                func(ctx context.Context, userID string) (*treezor.User, *http.Response, error)
+	GetManyFunc            func(ctx context.Context, userIDs []string, opt *treezor.GetManyOptions) ([]*treezor.User, error)
+	ListFunc               func(ctx context.Context, opt *treezor.UserListOptions) (*treezor.UserResponse, *http.Response, error)
+	ListAllFunc            func(ctx context.Context, opt *treezor.UserListOptions) ([]*treezor.User, error)
+	RequestKYCLivenessFunc func(ctx context.Context, treezorUserID string) (*treezor.Identification, *http.Response, error)
+	ReviewKYCFunc          func(ctx context.Context, userID string) (*treezor.User, *http.Response, error)
+	ReviewKYCLivenessFunc  func(ctx context.Context, treezorUserID string) (*http.Response, error)
+}
+
+var _ treezor.UserAPI = (*UserAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *UserAPI) Cancel(ctx context.Context, userID string, opt *treezor.UserCancelOptions) (r0 *treezor.User, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, userID, opt)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, userID, opt)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *UserAPI) Create(ctx context.Context, user *treezor.User) (r0 *treezor.User, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, user)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, user)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc.
+func (m *UserAPI) Edit(ctx context.Context, userID string, user *treezor.User) (r0 *treezor.User, r1 *http.Response, r2 error) {
+	m.record("Edit", ctx, userID, user)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, userID, user)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *UserAPI) Get(ctx context.Context, userID string) (r0 *treezor.User, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, userID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, userID)
+	}
+	return
+}
+
+// GetMany records the call and calls GetManyFunc.
+func (m *UserAPI) GetMany(ctx context.Context, userIDs []string, opt *treezor.GetManyOptions) (r0 []*treezor.User, r1 error) {
+	m.record("GetMany", ctx, userIDs, opt)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(ctx, userIDs, opt)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *UserAPI) List(ctx context.Context, opt *treezor.UserListOptions) (r0 *treezor.UserResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *UserAPI) ListAll(ctx context.Context, opt *treezor.UserListOptions) (r0 []*treezor.User, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
+
+// RequestKYCLiveness records the call and calls RequestKYCLivenessFunc.
+func (m *UserAPI) RequestKYCLiveness(ctx context.Context, treezorUserID string) (r0 *treezor.Identification, r1 *http.Response, r2 error) {
+	m.record("RequestKYCLiveness", ctx, treezorUserID)
+	if m.RequestKYCLivenessFunc != nil {
+		return m.RequestKYCLivenessFunc(ctx, treezorUserID)
+	}
+	return
+}
+
+// ReviewKYC records the call and calls ReviewKYCFunc.
+func (m *UserAPI) ReviewKYC(ctx context.Context, userID string) (r0 *treezor.User, r1 *http.Response, r2 error) {
+	m.record("ReviewKYC", ctx, userID)
+	if m.ReviewKYCFunc != nil {
+		return m.ReviewKYCFunc(ctx, userID)
+	}
+	return
+}
+
+// ReviewKYCLiveness records the call and calls ReviewKYCLivenessFunc.
+func (m *UserAPI) ReviewKYCLiveness(ctx context.Context, treezorUserID string) (r0 *http.Response, r1 error) {
+	m.record("ReviewKYCLiveness", ctx, treezorUserID)
+	if m.ReviewKYCLivenessFunc != nil {
+		return m.ReviewKYCLivenessFunc(ctx, treezorUserID)
+	}
+	return
+}
+
+// WalletAPI is a mock of treezor.WalletAPI. Calls are recorded and
+// answered by the matching Func field, or with zero values if it is nil.
+type WalletAPI struct {
+	Mock
+
+	CancelFunc  func(ctx context.Context, walletID string, opt *treezor.WalletCancelOptions) (*treezor.Wallet, *http.Response, error)
+	CreateFunc  func(ctx context.Context, wallet *treezor.Wallet) (*treezor.Wallet, *http.Response, error)
+	EditFunc    func(ctx context.Context, walletID string, wallet *treezor.Wallet) (*treezor.Wallet, *http.Response, error)
+	GetFunc     func(ctx context.Context, walletID string) (*treezor.Wallet, *http.Response, error)
+	GetManyFunc func(ctx context.Context, walletIDs []string, opt *treezor.GetManyOptions) ([]*treezor.Wallet, error)
+	ListFunc    func(ctx context.Context, opt *treezor.WalletListOptions) (*treezor.WalletResponse, *http.Response, error)
+	ListAllFunc func(ctx context.Context, opt *treezor.WalletListOptions) ([]*treezor.Wallet, error)
+}
+
+var _ treezor.WalletAPI = (*WalletAPI)(nil)
+
+// Cancel records the call and calls CancelFunc.
+func (m *WalletAPI) Cancel(ctx context.Context, walletID string, opt *treezor.WalletCancelOptions) (r0 *treezor.Wallet, r1 *http.Response, r2 error) {
+	m.record("Cancel", ctx, walletID, opt)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(ctx, walletID, opt)
+	}
+	return
+}
+
+// Create records the call and calls CreateFunc.
+func (m *WalletAPI) Create(ctx context.Context, wallet *treezor.Wallet) (r0 *treezor.Wallet, r1 *http.Response, r2 error) {
+	m.record("Create", ctx, wallet)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, wallet)
+	}
+	return
+}
+
+// Edit records the call and calls EditFunc.
+func (m *WalletAPI) Edit(ctx context.Context, walletID string, wallet *treezor.Wallet) (r0 *treezor.Wallet, r1 *http.Response, r2 error) {
+	m.record("Edit", ctx, walletID, wallet)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, walletID, wallet)
+	}
+	return
+}
+
+// Get records the call and calls GetFunc.
+func (m *WalletAPI) Get(ctx context.Context, walletID string) (r0 *treezor.Wallet, r1 *http.Response, r2 error) {
+	m.record("Get", ctx, walletID)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, walletID)
+	}
+	return
+}
+
+// GetMany records the call and calls GetManyFunc.
+func (m *WalletAPI) GetMany(ctx context.Context, walletIDs []string, opt *treezor.GetManyOptions) (r0 []*treezor.Wallet, r1 error) {
+	m.record("GetMany", ctx, walletIDs, opt)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(ctx, walletIDs, opt)
+	}
+	return
+}
+
+// List records the call and calls ListFunc.
+func (m *WalletAPI) List(ctx context.Context, opt *treezor.WalletListOptions) (r0 *treezor.WalletResponse, r1 *http.Response, r2 error) {
+	m.record("List", ctx, opt)
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, opt)
+	}
+	return
+}
+
+// ListAll records the call and calls ListAllFunc.
+func (m *WalletAPI) ListAll(ctx context.Context, opt *treezor.WalletListOptions) (r0 []*treezor.Wallet, r1 error) {
+	m.record("ListAll", ctx, opt)
+	if m.ListAllFunc != nil {
+		return m.ListAllFunc(ctx, opt)
+	}
+	return
+}
@@ -0,0 +1,118 @@
+// Package treezorotel adapts OpenTelemetry tracers and meters to the Tracer
+// and Meter interfaces of the Treezor client.
+//
+// Example usage:
+//
+//	client := treezor.NewClient(httpClient, true)
+//	client.Tracer = treezorotel.NewTracer(otel.GetTracerProvider())
+//	client.Meter, err = treezorotel.NewMeter(otel.GetMeterProvider())
+//
+// It is a separate module so that the treezor package does not depend on
+// OpenTelemetry.
+package treezorotel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	treezor "github.com/tifo/treezor-sdk"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracer and meter created by the
+// adapters.
+const InstrumentationName = "github.com/tifo/treezor-sdk"
+
+// Names of the metrics recorded by the Meter.
+const (
+	MetricDuration = "treezor.client.duration"
+	MetricErrors   = "treezor.client.errors"
+)
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a treezor.Tracer starting client spans with a tracer of
+// tp.
+func NewTracer(tp trace.TracerProvider) treezor.Tracer {
+	return &tracer{tracer: tp.Tracer(InstrumentationName)}
+}
+
+func (t *tracer) Start(ctx context.Context, name string) (context.Context, treezor.Span) {
+	ctx, s := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &span{span: s}
+}
+
+type span struct {
+	span trace.Span
+}
+
+func (s *span) SetAttributes(attrs ...treezor.Attribute) {
+	s.span.SetAttributes(convert(attrs)...)
+}
+
+func (s *span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s *span) End() {
+	s.span.End()
+}
+
+type meter struct {
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+}
+
+// NewMeter returns a treezor.Meter recording the duration of the API calls, in
+// seconds, and counting their errors with a meter of mp.
+func NewMeter(mp metric.MeterProvider) (treezor.Meter, error) {
+	m := mp.Meter(InstrumentationName)
+	duration, err := m.Float64Histogram(MetricDuration,
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of the Treezor API calls, retries included."))
+	if err != nil {
+		return nil, err
+	}
+	errors, err := m.Int64Counter(MetricErrors,
+		metric.WithDescription("Number of failed Treezor API calls."))
+	if err != nil {
+		return nil, err
+	}
+	return &meter{duration: duration, errors: errors}, nil
+}
+
+func (m *meter) RecordLatency(ctx context.Context, latency time.Duration, attrs ...treezor.Attribute) {
+	m.duration.Record(ctx, latency.Seconds(), metric.WithAttributes(convert(attrs)...))
+}
+
+func (m *meter) AddError(ctx context.Context, attrs ...treezor.Attribute) {
+	m.errors.Add(ctx, 1, metric.WithAttributes(convert(attrs)...))
+}
+
+func convert(attrs []treezor.Attribute) []attribute.KeyValue {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		switch v := a.Value.(type) {
+		case string:
+			kvs = append(kvs, attribute.String(a.Key, v))
+		case int:
+			kvs = append(kvs, attribute.Int(a.Key, v))
+		case int64:
+			kvs = append(kvs, attribute.Int64(a.Key, v))
+		case bool:
+			kvs = append(kvs, attribute.Bool(a.Key, v))
+		case float64:
+			kvs = append(kvs, attribute.Float64(a.Key, v))
+		default:
+			kvs = append(kvs, attribute.String(a.Key, fmt.Sprint(v)))
+		}
+	}
+	return kvs
+}
@@ -0,0 +1,74 @@
+package treezorotel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	treezor "github.com/tifo/treezor-sdk"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAdapters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/cards/2" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":[{"errorCode":32095,"errorMessage":"card lost"}]}`))
+			return
+		}
+		w.Write([]byte(`{"cards":[{"cardId":"1"}]}`))
+	}))
+	defer server.Close()
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	client := treezor.NewClient(nil, false)
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	client.Tracer = NewTracer(tp)
+	m, err := NewMeter(mp)
+	assert.Nil(t, err)
+	client.Meter = m
+
+	ctx := context.Background()
+	_, _, err = client.Card.Get(ctx, "1")
+	assert.Nil(t, err)
+	_, _, err = client.Card.Get(ctx, "2")
+	assert.NotNil(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 2)
+	assert.Equal(t, "CardService.Get", spans[0].Name)
+	assert.Contains(t, spans[0].Attributes, attribute.Int(treezor.AttributeStatusCode, http.StatusOK))
+	assert.Contains(t, spans[0].Attributes, attribute.Int(treezor.AttributeRetryCount, 0))
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.Contains(t, spans[1].Attributes, attribute.Int(treezor.AttributeErrorCode, 32095))
+
+	var rm metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(ctx, &rm))
+	metrics := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	duration := metrics[MetricDuration].(metricdata.Histogram[float64])
+	var count uint64
+	for _, dp := range duration.DataPoints {
+		count += dp.Count
+	}
+	assert.Equal(t, uint64(2), count)
+	errs := metrics[MetricErrors].(metricdata.Sum[int64])
+	assert.Len(t, errs.DataPoints, 1)
+	assert.Equal(t, int64(1), errs.DataPoints[0].Value)
+}
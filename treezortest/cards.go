@@ -0,0 +1,381 @@
+package treezortest
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+// Status codes of a card.
+const (
+	cardUnlock    = "UNLOCK"
+	cardLock      = "LOCK"
+	cardLost      = "LOST"
+	cardStolen    = "STOLEN"
+	cardDestroyed = "DESTROYED"
+)
+
+// defaultPIN is the PIN of the cards created by the server.
+const defaultPIN = "1234"
+
+// maxPINTries is the number of wrong PINs after which the PIN is blocked.
+const maxPINTries = 3
+
+var lockStatusCodes = map[treezor.LockStatus]string{
+	treezor.Unlocked: cardUnlock,
+	treezor.Locked:   cardLock,
+	treezor.Lost:     cardLost,
+	treezor.Stolen:   cardStolen,
+}
+
+// card is a stored card along with its secrets.
+type card struct {
+	*treezor.Card
+
+	pin      string
+	pinTries int
+}
+
+func (s *Server) card(id string) (*card, error) {
+	c, ok := s.cards[id]
+	if !ok {
+		return nil, notFound("card", id)
+	}
+	return c, nil
+}
+
+// usableCard returns the card with the given ID if it can still be operated,
+// i.e. it has not been declared lost or stolen, nor destroyed.
+func (s *Server) usableCard(id string) (*card, error) {
+	c, err := s.card(id)
+	if err != nil {
+		return nil, err
+	}
+	switch c.GetStatusCode() {
+	case cardLost:
+		return nil, codeError(treezor.ErrCardLost)
+	case cardStolen:
+		return nil, codeError(treezor.ErrCardStolen)
+	case cardDestroyed:
+		return nil, badRequest("card %s is destroyed", id)
+	}
+	return c, nil
+}
+
+// cardUpdated marks c as modified and emits eventType for it.
+func (s *Server) cardUpdated(c *card, eventType string) *treezor.CardResponse {
+	c.ModifiedDate = treezor.NewTimestampLondon(time.Now())
+	resp := &treezor.CardResponse{Cards: []*treezor.Card{c.Card}}
+	s.emit(eventType, c.GetCardID(), resp)
+	return resp
+}
+
+func (s *Server) newCard(r *request, physical bool) (*card, error) {
+	tc := &treezor.Card{}
+	if err := r.decode(tc); err != nil {
+		return nil, err
+	}
+	w, err := s.activeWallet(tc.GetWalletID())
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.activeUser(tc.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	if tc.PermsGroup == nil {
+		tc.PermsGroup = treezor.String(treezor.ConvertPermissions(treezor.All))
+	}
+	tc.CardID = s.newStringID()
+	tc.IsPhysical = treezor.Int64(0)
+	tc.IsLive = treezor.Int64(1)
+	if physical {
+		tc.IsPhysical = treezor.Int64(1)
+		tc.IsLive = treezor.Int64(0)
+	}
+	tc.StatusCode = treezor.String(cardUnlock)
+	tc.LockStatus = treezor.Int64(int64(treezor.Unlocked))
+	tc.PINTryExceeds = treezor.Int64(0)
+	tc.VirtualConverted = treezor.Int64(0)
+	tc.MaskedPan = treezor.String(fmt.Sprintf("548821XXXXXX%04s", tc.GetCardID()))
+	tc.PublicToken = treezor.String(fmt.Sprintf("%09s", tc.GetCardID()))
+	if tc.EmbossedName == nil {
+		tc.EmbossedName = treezor.String(u.GetFirstname() + " " + u.GetLastname())
+	}
+	tc.ExpiryDate = treezor.NewDate(time.Now().AddDate(3, 0, 0))
+	tc.CurrencyCode = w.Currency
+	tc.OptionATM, tc.OptionForeign, tc.OptionOnline, tc.OptionNFC = treezor.Int64(1), treezor.Int64(1), treezor.Int64(1), treezor.Int64(1)
+	tc.CreatedDate = treezor.NewTimestampLondon(time.Now())
+
+	c := &card{Card: tc, pin: defaultPIN}
+	s.cards[tc.GetCardID()] = c
+	return c, nil
+}
+
+func (s *Server) createVirtualCard(r *request) (interface{}, error) {
+	c, err := s.newCard(r, false)
+	if err != nil {
+		return nil, err
+	}
+	resp := &treezor.CardResponse{Cards: []*treezor.Card{c.Card}}
+	s.emit("card.createvirtual", c.GetCardID(), resp)
+	return resp, nil
+}
+
+func (s *Server) requestPhysicalCard(r *request) (interface{}, error) {
+	c, err := s.newCard(r, true)
+	if err != nil {
+		return nil, err
+	}
+	resp := &treezor.CardResponse{Cards: []*treezor.Card{c.Card}}
+	s.emit("card.requestphysical", c.GetCardID(), resp)
+	return resp, nil
+}
+
+func (s *Server) register3DS(r *request) (interface{}, error) {
+	req := &treezor.Card3DS{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	c, err := s.usableCard(req.GetCardID())
+	if err != nil {
+		return nil, err
+	}
+	return &treezor.CardResponse{Cards: []*treezor.Card{c.Card}}, nil
+}
+
+func (s *Server) getCard(r *request) (interface{}, error) {
+	c, err := s.card(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	return &treezor.CardResponse{Cards: []*treezor.Card{c.Card}}, nil
+}
+
+func (s *Server) listCards(r *request) (interface{}, error) {
+	var ids []string
+	for id, c := range s.cards {
+		if filter(r, "userId", c.UserID) && filter(r, "walletId", c.WalletID) {
+			ids = append(ids, id)
+		}
+	}
+	sortedIDs(ids)
+	start, end := page(r, len(ids))
+	resp := &treezor.CardResponse{Cards: []*treezor.Card{}}
+	for _, id := range ids[start:end] {
+		c := *s.cards[id].Card
+		c.TotalRows = treezor.Int64(int64(len(ids)))
+		resp.Cards = append(resp.Cards, &c)
+	}
+	return resp, nil
+}
+
+func (s *Server) editCard(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	tc := c.Card
+	id, status, lock := tc.CardID, tc.StatusCode, tc.LockStatus
+	if err := merge(r, tc); err != nil {
+		return nil, err
+	}
+	tc.CardID, tc.StatusCode, tc.LockStatus = id, status, lock
+	return s.cardUpdated(c, "card.update"), nil
+}
+
+func (s *Server) deactivateCard(r *request) (interface{}, error) {
+	c, err := s.card(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	if c.GetStatusCode() == cardDestroyed {
+		return nil, badRequest("card %s is destroyed", c.GetCardID())
+	}
+	c.StatusCode = treezor.String(cardDestroyed)
+	c.IsLive = treezor.Int64(0)
+	return s.cardUpdated(c, "card.update"), nil
+}
+
+func (s *Server) activateCard(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	if c.GetIsLive() == 1 {
+		return nil, badRequest("card %s is already activated", c.GetCardID())
+	}
+	c.IsLive = treezor.Int64(1)
+	return s.cardUpdated(c, "card.activate"), nil
+}
+
+// lockUnlockCard changes the lock status of a card. A card declared lost or
+// stolen cannot be unlocked anymore.
+func (s *Server) lockUnlockCard(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	req := &treezor.Card{}
+	if err := r.decode(req); err != nil {
+		return nil, err
+	}
+	lock := treezor.LockStatus(req.GetLockStatus())
+	code, ok := lockStatusCodes[lock]
+	if !ok {
+		return nil, badRequest("invalid lockStatus %d", lock)
+	}
+	c.LockStatus = treezor.Int64(int64(lock))
+	c.StatusCode = treezor.String(code)
+	return s.cardUpdated(c, "card.lockunlock"), nil
+}
+
+func (s *Server) changeCardOptions(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	opt := &treezor.CardOptions{}
+	if err := r.decode(opt); err != nil {
+		return nil, err
+	}
+	perms := treezor.Noop
+	for _, o := range []struct {
+		value, perm int
+		field       **int64
+	}{
+		{opt.Foreign, treezor.Foreign, &c.OptionForeign},
+		{opt.Online, treezor.Online, &c.OptionOnline},
+		{opt.ATM, treezor.ATM, &c.OptionATM},
+		{opt.NFC, treezor.NFC, &c.OptionNFC},
+	} {
+		*o.field = treezor.Int64(int64(o.value))
+		if o.value != 0 {
+			perms |= o.perm
+		}
+	}
+	c.PermsGroup = treezor.String(treezor.ConvertPermissions(perms))
+	return s.cardUpdated(c, "card.options"), nil
+}
+
+func (s *Server) changeCardLimits(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	l := &treezor.CardLimits{}
+	if err := r.decode(l); err != nil {
+		return nil, err
+	}
+	c.LimitATMYear, c.LimitATMMonth = treezor.Int64(l.LimitATMYear), treezor.Int64(l.LimitATMMonth)
+	c.LimitATMWeek, c.LimitATMDay = treezor.Int64(l.LimitATMWeek), treezor.Int64(l.LimitATMDay)
+	c.LimitATMAll = treezor.Int64(l.LimitATMAll)
+	c.LimitPaymentYear, c.LimitPaymentMonth = treezor.Int64(l.LimitPaymentYear), treezor.Int64(l.LimitPaymentMonth)
+	c.LimitPaymentWeek, c.LimitPaymentDay = treezor.Int64(l.LimitPaymentWeek), treezor.Int64(l.LimitPaymentDay)
+	c.LimitPaymentAll = treezor.Int64(l.LimitPaymentAll)
+	return s.cardUpdated(c, "card.limits"), nil
+}
+
+func (s *Server) regenerateCard(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	if c.GetIsPhysical() == 1 {
+		return nil, badRequest("card %s is not virtual", c.GetCardID())
+	}
+	return s.cardUpdated(c, "card.regenerate"), nil
+}
+
+func (s *Server) convertVirtualCard(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	if c.GetIsPhysical() == 1 {
+		return nil, badRequest("card %s is not virtual", c.GetCardID())
+	}
+	c.IsPhysical = treezor.Int64(1)
+	c.VirtualConverted = treezor.Int64(1)
+	return s.cardUpdated(c, "card.convertvirtual"), nil
+}
+
+// changeCardPIN checks the current PIN of a card before changing it. The PIN
+// is blocked after maxPINTries wrong PINs.
+func (s *Server) changeCardPIN(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	pin := &treezor.PIN{}
+	if err := r.decode(pin); err != nil {
+		return nil, err
+	}
+	if c.GetPINTryExceeds() == 1 {
+		return nil, codeError(treezor.ErrCardBlocked)
+	}
+	if pin.Current != c.pin {
+		c.pinTries++
+		if c.pinTries >= maxPINTries {
+			c.PINTryExceeds = treezor.Int64(1)
+		}
+		return nil, codeError(treezor.ErrCardWrongPIN)
+	}
+	if err := c.setPIN(pin); err != nil {
+		return nil, err
+	}
+	return s.cardUpdated(c, "card.changepin"), nil
+}
+
+func (s *Server) setCardPIN(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	pin := &treezor.PIN{}
+	if err := r.decode(pin); err != nil {
+		return nil, err
+	}
+	if err := c.setPIN(pin); err != nil {
+		return nil, err
+	}
+	return s.cardUpdated(c, "card.setpin"), nil
+}
+
+func (s *Server) unblockCardPIN(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	c.pinTries = 0
+	c.PINTryExceeds = treezor.Int64(0)
+	return s.cardUpdated(c, "card.unblockpin"), nil
+}
+
+// setPIN replaces the PIN of c, which also unblocks it.
+func (c *card) setPIN(pin *treezor.PIN) error {
+	if len(pin.New) != 4 || pin.New != pin.Confirmation {
+		return badRequest("new PIN must be 4 digits and match its confirmation")
+	}
+	c.pin = pin.New
+	c.pinTries = 0
+	c.PINTryExceeds = treezor.Int64(0)
+	return nil
+}
+
+func (s *Server) getCardImage(r *request) (interface{}, error) {
+	c, err := s.usableCard(r.URL.Query().Get("cardId"))
+	if err != nil {
+		return nil, err
+	}
+	if c.GetIsPhysical() == 1 {
+		return nil, badRequest("card %s is not virtual", c.GetCardID())
+	}
+	return &treezor.CardImagesResponse{CardImages: []*treezor.CardImage{{
+		ID:     treezor.String(c.GetCardID()),
+		CardID: c.CardID,
+		File:   treezor.String(base64.StdEncoding.EncodeToString([]byte("card " + c.GetMaskedPan()))),
+	}}}, nil
+}
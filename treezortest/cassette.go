@@ -0,0 +1,403 @@
+package treezortest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Redacted replaces the sensitive values stored in cassettes.
+const Redacted = "REDACTED"
+
+// ErrNoInteraction is returned in replay mode when no recorded interaction
+// matches a request.
+var ErrNoInteraction = errors.New("no matching interaction in cassette")
+
+// Mode is the mode of a Recorder.
+type Mode int
+
+// List of Mode.
+const (
+	// ModeRecord sends the requests to the API and records them.
+	ModeRecord Mode = iota
+
+	// ModeReplay answers the requests with the recorded responses, without
+	// sending anything to the API.
+	ModeReplay
+)
+
+// Cassette is a list of recorded HTTP interactions.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded HTTP request. Sensitive values are redacted.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded HTTP response. Sensitive values are
+// redacted.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording HTTP interactions into a JSON
+// cassette file, or replaying them from it, so that service methods can be
+// tested without live credentials.
+//
+// Recorded interactions are redacted: Authorization headers, client secrets,
+// access tokens, IBANs, card numbers, CVVs and PINs are replaced by Redacted.
+// In replay mode, a request matches an interaction with the same method,
+// path, query and body, once redacted; each interaction is replayed once, in
+// the recorded order.
+//
+// Example usage:
+//
+//	rec, err := treezortest.NewRecorder("testdata/payouts.json", treezortest.ModeReplay)
+//	if err != nil { ... }
+//	defer rec.Stop()
+//	client := treezor.NewClient(rec.Client(), false)
+type Recorder struct {
+	// Transport sends the requests in record mode.
+	// It defaults to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	path string
+	mode Mode
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// NewRecorder returns a Recorder using the cassette file at path. In replay
+// mode, the cassette is loaded from the file; in record mode, it is written to
+// the file by Stop.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode, cassette: &Cassette{}}
+	if mode == ModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if err := json.Unmarshal(data, r.cassette); err != nil {
+			return nil, errors.Wrap(err, path)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Client returns an *http.Client using the Recorder as transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Stop writes the cassette file in record mode. It does nothing in replay
+// mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(ioutil.WriteFile(r.path, append(data, '\n'), 0644))
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := recordRequest(req, body)
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	resp, err := r.transport().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     redactHeader(resp.Header),
+			Body:       redactBody(resp.Header.Get("Content-Type"), respBody),
+		},
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || !matchRequest(&in.Request, &recorded) {
+			continue
+		}
+		r.used[i] = true
+		return &http.Response{
+			Status:        http.StatusText(in.Response.StatusCode),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(strings.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, errors.Wrapf(ErrNoInteraction, "%s %s", req.Method, recorded.URL)
+}
+
+func (r *Recorder) transport() http.RoundTripper {
+	if r.Transport == nil {
+		return http.DefaultTransport
+	}
+	return r.Transport
+}
+
+// readBody reads the body of req and rewinds it.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func recordRequest(req *http.Request, body []byte) RecordedRequest {
+	u := *req.URL
+	u.RawQuery = redactValues(u.Query()).Encode()
+	return RecordedRequest{
+		Method: req.Method,
+		URL:    u.String(),
+		Header: redactHeader(req.Header),
+		Body:   redactBody(req.Header.Get("Content-Type"), body),
+	}
+}
+
+// matchRequest reports whether the recorded request a matches b on method,
+// path, query and body. The host is ignored so that cassettes recorded
+// against the sandbox can be replayed against any base URL.
+func matchRequest(a, b *RecordedRequest) bool {
+	if a.Method != b.Method {
+		return false
+	}
+	ua, erra := url.Parse(a.URL)
+	ub, errb := url.Parse(b.URL)
+	if erra != nil || errb != nil {
+		return false
+	}
+	if strings.TrimSuffix(ua.Path, "/") != strings.TrimSuffix(ub.Path, "/") ||
+		!reflect.DeepEqual(ua.Query(), ub.Query()) {
+		return false
+	}
+	return equalBodies(a.Body, b.Body)
+}
+
+// equalBodies compares two bodies, as JSON values if they both are.
+func equalBodies(a, b string) bool {
+	if a == b {
+		return true
+	}
+	va, err := decodeJSON([]byte(a))
+	if err != nil {
+		return false
+	}
+	vb, err := decodeJSON([]byte(b))
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// decodeJSON decodes the JSON value data, keeping numbers as json.Number so
+// that large IDs keep their precision.
+func decodeJSON(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid character after top-level value")
+	}
+	return v, nil
+}
+
+// sensitiveHeaders are the headers redacted from cassettes.
+var sensitiveHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+func redactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range sensitiveHeaders {
+		if _, ok := h[k]; ok {
+			h.Set(k, Redacted)
+		}
+	}
+	return h
+}
+
+// sensitiveKeys are the JSON keys and form fields redacted from cassettes,
+// lowercased. IBAN fields are listed one by one: identifiers such as ibanId
+// or virtualIbanId are not account numbers and must stay readable.
+var sensitiveKeys = map[string]bool{
+	"client_secret":   true,
+	"access_token":    true,
+	"password":        true,
+	"pan":             true,
+	"cardnumber":      true,
+	"cvv":             true,
+	"cvc":             true,
+	"pin":             true,
+	"currentpin":      true,
+	"newpin":          true,
+	"confirmpin":      true,
+	"iban":            true,
+	"bankaccountiban": true,
+	"beneficiaryiban": true,
+	"creditoriban":    true,
+	"creditor_iban":   true,
+	"debtoriban":      true,
+	"debitor_iban":    true,
+	"dbtriban":        true,
+}
+
+func isSensitiveKey(k string) bool {
+	return sensitiveKeys[strings.ToLower(k)]
+}
+
+func redactValues(v url.Values) url.Values {
+	out := url.Values{}
+	for k, vs := range v {
+		if isSensitiveKey(k) {
+			vs = []string{Redacted}
+		}
+		out[k] = vs
+	}
+	return out
+}
+
+// redactBody redacts a JSON or form-encoded body. Other bodies are kept as is.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if v, err := url.ParseQuery(string(body)); err == nil {
+			return redactValues(v).Encode()
+		}
+	}
+	v, err := decodeJSON(body)
+	if err != nil {
+		return redactPANs(string(body))
+	}
+	data, err := json.Marshal(redactJSON(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(data)
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if isSensitiveKey(k) && e != nil {
+				v[k] = Redacted
+			} else {
+				v[k] = redactJSON(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactJSON(e)
+		}
+	case string:
+		return redactPANs(v)
+	case json.Number:
+		if redactPANs(v.String()) != v.String() {
+			return Redacted
+		}
+	}
+	return v
+}
+
+var panPattern = regexp.MustCompile(`\b\d{13,19}\b`)
+
+// redactPANs redacts the card numbers found in s, i.e. the sequences of 13 to
+// 19 digits passing the Luhn check.
+func redactPANs(s string) string {
+	return panPattern.ReplaceAllStringFunc(s, func(digits string) string {
+		if luhn(digits) {
+			return Redacted
+		}
+		return digits
+	})
+}
+
+func luhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,103 @@
+package treezortest
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+func TestRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	ctx := context.Background()
+	const iban = "FR7630001007941234567890185"
+
+	scenario := func(client *treezor.Client) (*treezor.Beneficiary, *treezor.Card, error) {
+		user, _, err := client.User.Create(ctx, &treezor.User{Email: treezor.String("jane@example.com")})
+		if err != nil {
+			return nil, nil, err
+		}
+		b, _, err := client.Beneficiary.Create(ctx, &treezor.BeneficiaryRequest{
+			UserID: user.UserID,
+			Name:   treezor.String("Jane Doe"),
+			IBAN:   treezor.String(iban),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		w, _, err := client.Wallet.Create(ctx, &treezor.Wallet{UserID: user.UserID, Currency: treezor.EUR})
+		if err != nil {
+			return nil, nil, err
+		}
+		card, _, err := client.Card.CreateVirtual(ctx, &treezor.Card{UserID: user.UserID, WalletID: w.WalletID})
+		if err != nil {
+			return nil, nil, err
+		}
+		_, _, err = client.Card.ChangePIN(ctx, card.GetCardID(), &treezor.PIN{Current: defaultPIN, New: "4321", Confirmation: "4321"})
+		return b, card, err
+	}
+
+	srv := NewServer()
+	defer srv.Close()
+	rec, err := NewRecorder(path, ModeRecord)
+	assert.Nil(t, err)
+	rec.Transport = srv.srv.Client().Transport
+	client := treezor.NewClient((&treezor.BearerAuthTransport{AccessToken: "secret-token", Transport: rec}).Client(), false)
+	client.BaseURL, _ = url.Parse(srv.URL + "/v1/index.php/")
+	client.BaseURLWithoutIndex, _ = url.Parse(srv.URL + "/v1/")
+	recorded, _, err := scenario(client)
+	assert.Nil(t, err)
+	assert.Nil(t, rec.Stop())
+
+	data, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), iban)
+	assert.NotContains(t, string(data), defaultPIN)
+	assert.Contains(t, string(data), Redacted)
+
+	t.Run("Replay", func(t *testing.T) {
+		rec, err := NewRecorder(path, ModeReplay)
+		assert.Nil(t, err)
+		client := treezor.NewClient(rec.Client(), false)
+		client.BaseURL, _ = url.Parse("http://replay.invalid/v1/index.php/")
+		client.BaseURLWithoutIndex, _ = url.Parse("http://replay.invalid/v1/")
+		replayed, card, err := scenario(client)
+		assert.Nil(t, err)
+		assert.Equal(t, recorded.GetBeneficiaryID(), replayed.GetBeneficiaryID())
+		assert.Equal(t, Redacted, replayed.GetEncryptedIBAN())
+
+		_, _, err = client.Card.Get(ctx, card.GetCardID())
+		assert.True(t, errors.Is(err, ErrNoInteraction))
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{"json keys", "application/json", `{"iban":"FR76","cvv":"123","name":"x"}`, `{"cvv":"REDACTED","iban":"REDACTED","name":"x"}`},
+		{"nested", "application/json", `{"cards":[{"beneficiaryIban":"FR76","pan":null}]}`, `{"cards":[{"beneficiaryIban":"REDACTED","pan":null}]}`},
+		{"iban identifiers", "application/json", `{"ibanId":"1","virtualIbanId":"2","ibanTxEndToEndId":"3","debtorIban":"FR76"}`, `{"debtorIban":"REDACTED","ibanId":"1","ibanTxEndToEndId":"3","virtualIbanId":"2"}`},
+		{"pan in value", "application/json", `{"label":"card 4970101234567890"}`, `{"label":"card 4970101234567890"}`},
+		{"luhn pan in value", "application/json", `{"label":"card 4111111111111111"}`, `{"label":"card REDACTED"}`},
+		{"luhn pan number", "application/json", `{"label":4111111111111111}`, `{"label":"REDACTED"}`},
+		{"large id", "application/json", `{"transactionId":12345678901234567890,"amount":10.50}`, `{"amount":10.50,"transactionId":12345678901234567890}`},
+		{"form", "application/x-www-form-urlencoded", "client_id=a&client_secret=b", "client_id=a&client_secret=REDACTED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, redactBody(tt.contentType, []byte(tt.body)))
+		})
+	}
+	assert.Equal(t, Redacted, redactHeader(http.Header{"Authorization": {"Bearer x"}}).Get("Authorization"))
+}
@@ -0,0 +1,315 @@
+package treezortest
+
+import (
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+func (s *Server) createPayin(r *request) (interface{}, error) {
+	p := &treezor.Payin{}
+	if err := r.decode(p); err != nil {
+		return nil, err
+	}
+	w, err := s.activeWallet(p.GetWalletID())
+	if err != nil {
+		return nil, err
+	}
+	if p.Currency != w.Currency {
+		return nil, badRequest("currency %q does not match wallet currency %q", p.Currency, w.Currency)
+	}
+	if _, err := w.minorUnits(p.Amount); err != nil {
+		return nil, err
+	}
+	p.PayinID = s.newStringID()
+	p.PayinStatus = treezor.String(statusPending)
+	p.UserID = w.UserID
+	p.WalletEventName, p.WalletAlias = w.Name, w.Alias
+	p.CreatedDate = now()
+	s.payins[p.GetPayinID()] = p
+
+	resp := &treezor.PayinResponse{Payins: []*treezor.Payin{p}}
+	s.emit("payin.create", p.GetPayinID(), resp)
+	return resp, nil
+}
+
+func (s *Server) getPayin(r *request) (interface{}, error) {
+	p, ok := s.payins[r.params[0]]
+	if !ok {
+		return nil, notFound("payin", r.params[0])
+	}
+	return &treezor.PayinResponse{Payins: []*treezor.Payin{p}}, nil
+}
+
+func (s *Server) listPayins(r *request) (interface{}, error) {
+	var ids []string
+	for id, p := range s.payins {
+		if filter(r, "walletId", p.WalletID) && filter(r, "userId", p.UserID) && filter(r, "payinStatus", p.PayinStatus) {
+			ids = append(ids, id)
+		}
+	}
+	sortedIDs(ids)
+	start, end := page(r, len(ids))
+	resp := &treezor.PayinResponse{Payins: []*treezor.Payin{}}
+	for _, id := range ids[start:end] {
+		p := *s.payins[id]
+		p.TotalRows = treezor.Int64(int64(len(ids)))
+		resp.Payins = append(resp.Payins, &p)
+	}
+	return resp, nil
+}
+
+func (s *Server) cancelPayin(r *request) (interface{}, error) {
+	p, ok := s.payins[r.params[0]]
+	if !ok {
+		return nil, notFound("payin", r.params[0])
+	}
+	if p.GetPayinStatus() != statusPending {
+		return nil, badRequest("payin %s is %s", p.GetPayinID(), p.GetPayinStatus())
+	}
+	p.PayinStatus = treezor.String(statusCanceled)
+
+	resp := &treezor.PayinResponse{Payins: []*treezor.Payin{p}}
+	s.emit("payin.cancel", p.GetPayinID(), resp)
+	return resp, nil
+}
+
+// ValidatePayin settles a pending pay-in: its amount is credited to its
+// wallet and payin.update and balance.update events are emitted.
+func (s *Server) ValidatePayin(payinID string) error {
+	s.mu.Lock()
+	err := s.validatePayin(payinID)
+	pending := s.flushWebhooks()
+	s.mu.Unlock()
+	s.sendWebhooks(pending)
+	return err
+}
+
+func (s *Server) validatePayin(payinID string) error {
+	p, ok := s.payins[payinID]
+	if !ok {
+		return notFound("payin", payinID)
+	}
+	if p.GetPayinStatus() != statusPending {
+		return badRequest("payin %s is %s", payinID, p.GetPayinStatus())
+	}
+	w, err := s.activeWallet(p.GetWalletID())
+	if err != nil {
+		return err
+	}
+	amount, err := w.minorUnits(p.Amount)
+	if err != nil {
+		return err
+	}
+	w.balance += amount
+	p.PayinStatus = treezor.String(statusValidated)
+	p.PayinDate = treezor.NewDate(now().Time)
+
+	s.emit("payin.update", payinID, &treezor.PayinResponse{Payins: []*treezor.Payin{p}})
+	s.emitBalance(w)
+	return nil
+}
+
+func (s *Server) createPayout(r *request) (interface{}, error) {
+	p := &treezor.Payout{}
+	if err := r.decode(p); err != nil {
+		return nil, err
+	}
+	w, err := s.activeWallet(p.GetWalletID())
+	if err != nil {
+		return nil, err
+	}
+	if p.Currency != w.Currency {
+		return nil, badRequest("currency %q does not match wallet currency %q", p.Currency, w.Currency)
+	}
+	if _, ok := s.beneficiaries[p.GetBeneficiaryID()]; !ok {
+		return nil, notFound("beneficiary", p.GetBeneficiaryID())
+	}
+	amount, err := w.minorUnits(p.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if amount > w.authorizedBalance() {
+		return nil, codeError(treezor.ErrInsufficientFunds)
+	}
+	w.authorizations += amount
+	p.PayoutID = s.newStringID()
+	p.PayoutStatus = treezor.String(statusPending)
+	p.UserID = w.UserID
+	p.WalletEventName, p.WalletAlias = w.Name, w.Alias
+	p.CreatedDate = now()
+	s.payouts[p.GetPayoutID()] = p
+
+	resp := &treezor.PayoutResponse{Payouts: []*treezor.Payout{p}}
+	s.emit("payout.create", p.GetPayoutID(), resp)
+	s.emitBalance(w)
+	return resp, nil
+}
+
+func (s *Server) getPayout(r *request) (interface{}, error) {
+	p, ok := s.payouts[r.params[0]]
+	if !ok {
+		return nil, notFound("payout", r.params[0])
+	}
+	return &treezor.PayoutResponse{Payouts: []*treezor.Payout{p}}, nil
+}
+
+func (s *Server) listPayouts(r *request) (interface{}, error) {
+	var ids []string
+	for id, p := range s.payouts {
+		if filter(r, "walletId", p.WalletID) && filter(r, "userId", p.UserID) && filter(r, "payoutStatus", p.PayoutStatus) {
+			ids = append(ids, id)
+		}
+	}
+	sortedIDs(ids)
+	start, end := page(r, len(ids))
+	resp := &treezor.PayoutResponse{Payouts: []*treezor.Payout{}}
+	for _, id := range ids[start:end] {
+		p := *s.payouts[id]
+		p.TotalRows = treezor.Int64(int64(len(ids)))
+		resp.Payouts = append(resp.Payouts, &p)
+	}
+	return resp, nil
+}
+
+func (s *Server) cancelPayout(r *request) (interface{}, error) {
+	p, ok := s.payouts[r.params[0]]
+	if !ok {
+		return nil, notFound("payout", r.params[0])
+	}
+	if p.GetPayoutStatus() != statusPending {
+		return nil, badRequest("payout %s is %s", p.GetPayoutID(), p.GetPayoutStatus())
+	}
+	w, err := s.wallet(p.GetWalletID())
+	if err != nil {
+		return nil, err
+	}
+	amount, _ := w.minorUnits(p.Amount)
+	w.authorizations -= amount
+	p.PayoutStatus = treezor.String(statusCanceled)
+	p.ModifiedDate = now()
+
+	resp := &treezor.PayoutResponse{Payouts: []*treezor.Payout{p}}
+	s.emit("payout.cancel", p.GetPayoutID(), resp)
+	s.emitBalance(w)
+	return resp, nil
+}
+
+// ValidatePayout executes a pending pay-out: its amount is debited from its
+// wallet and payout.update and balance.update events are emitted.
+func (s *Server) ValidatePayout(payoutID string) error {
+	s.mu.Lock()
+	err := s.validatePayout(payoutID)
+	pending := s.flushWebhooks()
+	s.mu.Unlock()
+	s.sendWebhooks(pending)
+	return err
+}
+
+func (s *Server) validatePayout(payoutID string) error {
+	p, ok := s.payouts[payoutID]
+	if !ok {
+		return notFound("payout", payoutID)
+	}
+	if p.GetPayoutStatus() != statusPending {
+		return badRequest("payout %s is %s", payoutID, p.GetPayoutStatus())
+	}
+	w, err := s.wallet(p.GetWalletID())
+	if err != nil {
+		return err
+	}
+	amount, _ := w.minorUnits(p.Amount)
+	w.authorizations -= amount
+	w.balance -= amount
+	p.PayoutStatus = treezor.String(statusValidated)
+	p.PayoutDate = treezor.NewDate(now().Time)
+	p.ModifiedDate = now()
+
+	s.emit("payout.update", payoutID, &treezor.PayoutResponse{Payouts: []*treezor.Payout{p}})
+	s.emitBalance(w)
+	return nil
+}
+
+// createTransfer executes a wallet to wallet transfer. Transfers are
+// validated immediately.
+func (s *Server) createTransfer(r *request) (interface{}, error) {
+	t := &treezor.Transfer{}
+	if err := r.decode(t); err != nil {
+		return nil, err
+	}
+	from, err := s.activeWallet(t.GetWalletID())
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.activeWallet(t.GetBeneficiaryWalletID())
+	if err != nil {
+		return nil, err
+	}
+	if t.Currency != from.Currency || t.Currency != to.Currency {
+		return nil, badRequest("currency %q does not match wallets currencies", t.Currency)
+	}
+	amount, err := from.minorUnits(t.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if amount > from.authorizedBalance() {
+		return nil, codeError(treezor.ErrInsufficientFunds)
+	}
+	from.balance -= amount
+	to.balance += amount
+
+	if t.TransferTypeID == "" {
+		t.TransferTypeID = treezor.Wallet2WalletTransfer
+	}
+	t.TransferID = s.newStringID()
+	t.TransferStatus = treezor.String(statusValidated)
+	t.WalletTypeID, t.BeneficiaryWalletTypeID = from.WalletTypeID, to.WalletTypeID
+	t.WalletEventName, t.WalletAlias = from.Name, from.Alias
+	t.BeneficiaryWalletEventName, t.BeneficiaryWalletAlias = to.Name, to.Alias
+	t.TransferDate = treezor.NewDate(now().Time)
+	t.CreatedDate = now()
+	s.transfers[t.GetTransferID()] = t
+
+	resp := &treezor.TransferResponse{Transfers: []*treezor.Transfer{t}}
+	s.emit("transfer.create", t.GetTransferID(), resp)
+	s.emitBalance(from)
+	s.emitBalance(to)
+	return resp, nil
+}
+
+func (s *Server) getTransfer(r *request) (interface{}, error) {
+	t, ok := s.transfers[r.params[0]]
+	if !ok {
+		return nil, notFound("transfer", r.params[0])
+	}
+	return &treezor.TransferResponse{Transfers: []*treezor.Transfer{t}}, nil
+}
+
+func (s *Server) listTransfers(r *request) (interface{}, error) {
+	var ids []string
+	for id, t := range s.transfers {
+		typeID := string(t.TransferTypeID)
+		if filter(r, "walletId", t.WalletID) && filter(r, "beneficiaryWalletId", t.BeneficiaryWalletID) &&
+			filter(r, "transferStatus", t.TransferStatus) && filter(r, "transferTypeId", &typeID) &&
+			filter(r, "transferTag", t.TransferTag) {
+			ids = append(ids, id)
+		}
+	}
+	sortedIDs(ids)
+	start, end := page(r, len(ids))
+	resp := &treezor.TransferResponse{Transfers: []*treezor.Transfer{}}
+	for _, id := range ids[start:end] {
+		t := *s.transfers[id]
+		t.TotalRows = treezor.Int64(int64(len(ids)))
+		resp.Transfers = append(resp.Transfers, &t)
+	}
+	return resp, nil
+}
+
+// cancelTransfer always fails: transfers are validated on creation and a
+// validated transfer cannot be canceled.
+func (s *Server) cancelTransfer(r *request) (interface{}, error) {
+	t, ok := s.transfers[r.params[0]]
+	if !ok {
+		return nil, notFound("transfer", r.params[0])
+	}
+	return nil, badRequest("transfer %s is %s", t.GetTransferID(), t.GetTransferStatus())
+}
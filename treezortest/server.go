@@ -0,0 +1,440 @@
+// Package treezortest provides an in-memory fake of the Treezor API for
+// integration tests.
+//
+// A Server implements the endpoints called by the users, wallets, balances,
+// transfers, payins, payouts, cards, beneficiaries, documents and
+// taxResidences services of the SDK. It keeps its state in memory, applies
+// the status transitions of the real API, computes wallet balances and can
+// send signed webhooks to a configurable URL, so that full flows can be
+// exercised offline:
+//
+//	srv := treezortest.NewServer()
+//	defer srv.Close()
+//	client := srv.Client()
+//
+//	user, _, err := client.User.Create(ctx, &treezor.User{...})
+//
+// Operations which the real API performs asynchronously, such as the
+// settlement of a pay-in, are triggered by the methods of Server, e.g.
+// ValidatePayin.
+package treezortest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+// Server is a fake Treezor API server.
+type Server struct {
+	// URL of the server, e.g. "http://127.0.0.1:1234".
+	URL string
+
+	// WebhookURL, if set, receives a signed webhook event for every change
+	// of state, like the webhook endpoint configured at Treezor.
+	WebhookURL string
+
+	// WebhookSecret signs the webhook events.
+	WebhookSecret []byte
+
+	// WebhookClient sends the webhook events. http.DefaultClient is used if
+	// nil.
+	WebhookClient *http.Client
+
+	srv *httptest.Server
+
+	mu             sync.Mutex
+	lastID         int64
+	users          map[string]*treezor.User
+	wallets        map[string]*wallet
+	payins         map[string]*treezor.Payin
+	payouts        map[string]*treezor.Payout
+	transfers      map[string]*treezor.Transfer
+	cards          map[string]*card
+	beneficiaries  map[string]*treezor.BeneficiaryRequest
+	documents      map[string]*treezor.Document
+	taxResidences  map[int64]*treezor.TaxResidence
+	events         []*treezor.Event
+	webhookErrors  []error
+	webhookPending []*treezor.Event
+	accessTags     map[string][]byte
+}
+
+// NewServer starts and returns a new Server. The caller should call Close
+// when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		WebhookSecret: []byte("treezortest"),
+		users:         map[string]*treezor.User{},
+		wallets:       map[string]*wallet{},
+		payins:        map[string]*treezor.Payin{},
+		payouts:       map[string]*treezor.Payout{},
+		transfers:     map[string]*treezor.Transfer{},
+		cards:         map[string]*card{},
+		beneficiaries: map[string]*treezor.BeneficiaryRequest{},
+		documents:     map[string]*treezor.Document{},
+		taxResidences: map[int64]*treezor.TaxResidence{},
+		accessTags:    map[string][]byte{},
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a treezor.Client sending its requests to the server.
+func (s *Server) Client() *treezor.Client {
+	c := treezor.NewClient(s.srv.Client(), false)
+	c.BaseURL, _ = url.Parse(s.URL + "/v1/index.php/")
+	c.BaseURLWithoutIndex, _ = url.Parse(s.URL + "/v1/")
+	return c
+}
+
+// Events returns the webhook events emitted so far, oldest first, whether
+// WebhookURL is set or not.
+func (s *Server) Events() []*treezor.Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*treezor.Event(nil), s.events...)
+}
+
+// WebhookErrors returns the errors which occurred while sending webhook
+// events to WebhookURL.
+func (s *Server) WebhookErrors() []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]error(nil), s.webhookErrors...)
+}
+
+// route is a request handler. It is called with the server lock held.
+type route func(r *request) (interface{}, error)
+
+// request is an API request being handled.
+type request struct {
+	*http.Request
+	params []string // Path segments matched by "*".
+}
+
+// decode decodes the JSON body of the request into v.
+func (r *request) decode(v interface{}) error {
+	if r.Body == nil {
+		return nil
+	}
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err.Error() != "EOF" {
+		return badRequest("invalid JSON body: %v", err)
+	}
+	return nil
+}
+
+// routes returns the handlers of the API endpoints, by method and path
+// pattern. A "*" segment matches any value.
+func (s *Server) routes() map[string]route {
+	return map[string]route{
+		"GET heartbeats": func(r *request) (interface{}, error) { return struct{}{}, nil },
+
+		"POST users":                 s.createUser,
+		"GET users":                  s.listUsers,
+		"GET users/*":                s.getUser,
+		"PUT users/*":                s.editUser,
+		"DELETE users/*":             s.cancelUser,
+		"PUT users/*/Kycreview":      s.reviewKYC,
+		"POST users/*/kycliveness":   s.requestKYCLiveness,
+		"PUT users/*/kycliveness":    s.reviewKYCLiveness,
+		"POST documents":             s.sendDocument,
+		"GET documents/*":            s.getDocument,
+		"DELETE documents/*":         s.deleteDocument,
+		"POST taxResidences":         s.createTaxResidence,
+		"PUT taxResidences/*":        s.editTaxResidence,
+		"POST beneficiaries":         s.createBeneficiary,
+		"GET beneficiaries":          s.listBeneficiaries,
+		"GET beneficiaries/*":        s.getBeneficiary,
+		"PUT beneficiaries/*":        s.editBeneficiary,
+		"POST wallets":               s.createWallet,
+		"GET wallets":                s.listWallets,
+		"GET wallets/*":              s.getWallet,
+		"PUT wallets/*":              s.editWallet,
+		"DELETE wallets/*":           s.cancelWallet,
+		"GET balances":               s.listBalances,
+		"POST payins":                s.createPayin,
+		"GET payins":                 s.listPayins,
+		"GET payins/*":               s.getPayin,
+		"DELETE payins/*":            s.cancelPayin,
+		"POST payouts":               s.createPayout,
+		"GET payouts":                s.listPayouts,
+		"GET payouts/*":              s.getPayout,
+		"DELETE payouts/*":           s.cancelPayout,
+		"POST transfers":             s.createTransfer,
+		"GET transfers":              s.listTransfers,
+		"GET transfers/*":            s.getTransfer,
+		"DELETE transfers/*":         s.cancelTransfer,
+		"POST cards/CreateVirtual":   s.createVirtualCard,
+		"POST cards/RequestPhysical": s.requestPhysicalCard,
+		"POST cards/Register3DS":     s.register3DS,
+		"GET cards":                  s.listCards,
+		"GET cards/*":                s.getCard,
+		"PUT cards/*":                s.editCard,
+		"DELETE cards/*":             s.deactivateCard,
+		"PUT cards/*/Activate":       s.activateCard,
+		"PUT cards/*/LockUnlock":     s.lockUnlockCard,
+		"PUT cards/*/Options":        s.changeCardOptions,
+		"PUT cards/*/Limits":         s.changeCardLimits,
+		"PUT cards/*/Regenerate":     s.regenerateCard,
+		"PUT cards/*/ConvertVirtual": s.convertVirtualCard,
+		"PUT cards/*/ChangePIN":      s.changeCardPIN,
+		"PUT cards/*/setPIN":         s.setCardPIN,
+		"PUT cards/*/UnblockPIN":     s.unblockCardPIN,
+		"GET cardimages":             s.getCardImage,
+	}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	path := strings.TrimPrefix(req.URL.Path, "/v1/")
+	path = strings.TrimPrefix(path, "index.php/")
+	path = strings.Trim(path, "/")
+
+	h, params := matchRoute(s.routes(), req.Method, path)
+	if h == nil {
+		writeError(w, &apiError{status: http.StatusNotFound, message: "no route for " + req.Method + " " + path})
+		return
+	}
+
+	// A POST request carrying an accessTag is idempotent: it is answered
+	// with the response to the first request with the same accessTag.
+	var tag string
+	if req.Method == http.MethodPost && req.Body != nil {
+		data, _ := ioutil.ReadAll(req.Body)
+		req.Body = ioutil.NopCloser(bytes.NewReader(data))
+		var access treezor.Access
+		if json.Unmarshal(data, &access) == nil && access.GetIdempotencyKey() != "" {
+			tag = path + " " + access.GetIdempotencyKey()
+		}
+	}
+
+	// The response is encoded with the lock held since it refers to the
+	// state of the server.
+	var body []byte
+	var err error
+	s.mu.Lock()
+	if cached, ok := s.accessTags[tag]; ok && tag != "" {
+		body = cached
+	} else {
+		var v interface{}
+		v, err = h(&request{Request: req, params: params})
+		if err == nil {
+			body, err = json.Marshal(v)
+		}
+		if err == nil && tag != "" {
+			s.accessTags[tag] = body
+		}
+	}
+	pending := s.flushWebhooks()
+	s.mu.Unlock()
+	s.sendWebhooks(pending)
+
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+func matchRoute(routes map[string]route, method, path string) (route, []string) {
+	segs := strings.Split(path, "/")
+	for pattern, h := range routes {
+		parts := strings.SplitN(pattern, " ", 2)
+		if parts[0] != method {
+			continue
+		}
+		psegs := strings.Split(parts[1], "/")
+		if len(psegs) != len(segs) {
+			continue
+		}
+		var params []string
+		match := true
+		for i, p := range psegs {
+			if p == "*" {
+				params = append(params, segs[i])
+			} else if p != segs[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return h, params
+		}
+	}
+	return nil, nil
+}
+
+// apiError is an error answered with the JSON error format of the API.
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func badRequest(format string, args ...interface{}) error {
+	return &apiError{status: http.StatusBadRequest, message: fmt.Sprintf(format, args...)}
+}
+
+func notFound(kind, id string) error {
+	return &apiError{status: http.StatusNotFound, message: kind + " " + id + " not found"}
+}
+
+func codeError(code *treezor.ErrorCode) error {
+	return &apiError{status: http.StatusBadRequest, code: code.Code, message: code.Message}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	aerr, ok := err.(*apiError)
+	if !ok {
+		aerr = &apiError{status: http.StatusInternalServerError, message: err.Error()}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(aerr.status)
+	json.NewEncoder(w).Encode(map[string][]treezor.Error{
+		"errors": {{Code: aerr.code, Message: aerr.message}},
+	})
+}
+
+// newID returns a new unique object ID.
+func (s *Server) newID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+func (s *Server) newStringID() *string {
+	return treezor.String(strconv.FormatInt(s.newID(), 10))
+}
+
+func now() *treezor.TimestampParis {
+	return treezor.NewTimestampParis(time.Now())
+}
+
+// emit records a webhook event for the given object, wrapped in the list
+// format of the API, e.g. {"payins":[...]}. It is sent once the current
+// request has been handled.
+func (s *Server) emit(eventType, objectID string, payload interface{}) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		panic(err)
+	}
+	mac := hmac.New(sha256.New, s.WebhookSecret)
+	mac.Write(raw)
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	msg := json.RawMessage(raw)
+	evt := &treezor.Event{
+		ID:               s.newStringID(),
+		Type:             treezor.String(eventType),
+		Object:           treezor.String(strings.SplitN(eventType, ".", 2)[0]),
+		ObjectID:         treezor.String(objectID),
+		RawPayload:       &msg,
+		PayloadSignature: &signature,
+	}
+	s.events = append(s.events, evt)
+	s.webhookPending = append(s.webhookPending, evt)
+}
+
+// flushWebhooks returns the events emitted since the last call. It must be
+// called with the lock held.
+func (s *Server) flushWebhooks() []*treezor.Event {
+	pending := s.webhookPending
+	s.webhookPending = nil
+	return pending
+}
+
+// sendWebhooks sends events to WebhookURL. It must be called without the lock
+// held, so that the receiver of the webhooks can call the server back.
+func (s *Server) sendWebhooks(events []*treezor.Event) {
+	if s.WebhookURL == "" {
+		return
+	}
+	for _, evt := range events {
+		s.sendWebhook(evt)
+	}
+}
+
+func (s *Server) sendWebhook(evt *treezor.Event) {
+	client := s.WebhookClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	body, _ := json.Marshal(evt)
+	resp, err := client.Post(s.WebhookURL, "application/json", bytes.NewReader(body))
+	if err == nil {
+		resp.Body.Close()
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			err = errors.Errorf("webhook %s %s: %s", evt.GetType(), evt.GetID(), resp.Status)
+		}
+	}
+	if err != nil {
+		s.mu.Lock()
+		s.webhookErrors = append(s.webhookErrors, errors.WithStack(err))
+		s.mu.Unlock()
+	}
+}
+
+// page applies the pageNumber and pageCount query parameters to the n
+// matching objects and returns the bounds of the requested page.
+func page(r *request, n int) (int, int) {
+	q := r.URL.Query()
+	number, _ := strconv.Atoi(q.Get("pageNumber"))
+	count, _ := strconv.Atoi(q.Get("pageCount"))
+	if number < 1 {
+		number = 1
+	}
+	if count < 1 {
+		count = 50
+	}
+	start := (number - 1) * count
+	if start > n {
+		start = n
+	}
+	end := start + count
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+// sortedIDs sorts ids in numeric order and returns them.
+func sortedIDs(ids []string) []string {
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.ParseInt(ids[i], 10, 64)
+		b, _ := strconv.ParseInt(ids[j], 10, 64)
+		return a < b
+	})
+	return ids
+}
+
+// filter reports whether the query parameter name of r is empty or equal to
+// value.
+func filter(r *request, name string, value *string) bool {
+	want := r.URL.Query().Get(name)
+	return want == "" || (value != nil && *value == want)
+}
@@ -0,0 +1,202 @@
+package treezortest
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+// setup creates a user with two EUR wallets.
+func setup(t *testing.T, client *treezor.Client) (*treezor.User, *treezor.Wallet, *treezor.Wallet) {
+	ctx := context.Background()
+	user, _, err := client.User.Create(ctx, &treezor.User{
+		Email:     treezor.String("jane@example.com"),
+		Firstname: treezor.String("Jane"),
+		Lastname:  treezor.String("Doe"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wallets []*treezor.Wallet
+	for i := 0; i < 2; i++ {
+		w, _, err := client.Wallet.Create(ctx, &treezor.Wallet{UserID: user.UserID, Currency: treezor.EUR})
+		if err != nil {
+			t.Fatal(err)
+		}
+		wallets = append(wallets, w)
+	}
+	return user, wallets[0], wallets[1]
+}
+
+func TestServer_MoneyFlow(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+	user, w1, w2 := setup(t, client)
+
+	balance := func(w *treezor.Wallet) treezor.Money {
+		b, _, err := client.Balance.List(ctx, &treezor.BalanceOptions{WalletID: w.GetWalletID()})
+		assert.Nil(t, err)
+		return b.Balances[0].AuthorizedBalanceMoney()
+	}
+
+	payin := &treezor.Payin{WalletID: w1.WalletID, Currency: treezor.EUR, PaymentMethodID: treezor.String("21")}
+	payin.SetAmountMoney(treezor.NewMoney(10000, treezor.EUR))
+	payin, _, err := client.Payin.Create(ctx, payin)
+	assert.Nil(t, err)
+	assert.Equal(t, "PENDING", payin.GetPayinStatus())
+	assert.Equal(t, treezor.NewMoney(0, treezor.EUR), balance(w1))
+
+	assert.Nil(t, srv.ValidatePayin(payin.GetPayinID()))
+	payin, _, err = client.Payin.Get(ctx, payin.GetPayinID())
+	assert.Nil(t, err)
+	assert.Equal(t, "VALIDATED", payin.GetPayinStatus())
+	assert.Equal(t, treezor.NewMoney(10000, treezor.EUR), balance(w1))
+
+	t.Run("Transfer", func(t *testing.T) {
+		transfer := &treezor.Transfer{WalletID: w1.WalletID, BeneficiaryWalletID: w2.WalletID, Currency: treezor.EUR}
+		transfer.SetAmountMoney(treezor.NewMoney(2550, treezor.EUR))
+		transfer, _, err := client.Transfer.Create(ctx, transfer)
+		assert.Nil(t, err)
+		assert.Equal(t, "VALIDATED", transfer.GetTransferStatus())
+		assert.Equal(t, treezor.NewMoney(7450, treezor.EUR), balance(w1))
+		assert.Equal(t, treezor.NewMoney(2550, treezor.EUR), balance(w2))
+	})
+	t.Run("Transfer idempotency", func(t *testing.T) {
+		transfer := &treezor.Transfer{
+			Access:   treezor.Access{IdempotencyKey: treezor.String("tag")},
+			WalletID: w2.WalletID, BeneficiaryWalletID: w1.WalletID, Currency: treezor.EUR,
+		}
+		transfer.SetAmountMoney(treezor.NewMoney(50, treezor.EUR))
+		first, _, err := client.Transfer.Create(ctx, transfer)
+		assert.Nil(t, err)
+		second, _, err := client.Transfer.Create(ctx, transfer)
+		assert.Nil(t, err)
+		assert.Equal(t, first.GetTransferID(), second.GetTransferID())
+		assert.Equal(t, treezor.NewMoney(2500, treezor.EUR), balance(w2))
+	})
+	t.Run("Payout", func(t *testing.T) {
+		b, _, err := client.Beneficiary.Create(ctx, &treezor.BeneficiaryRequest{
+			UserID: user.UserID,
+			Name:   treezor.String("Jane Doe"),
+			IBAN:   treezor.String("FR7630001007941234567890185"),
+		})
+		assert.Nil(t, err)
+
+		payout := &treezor.Payout{WalletID: w1.WalletID, BeneficiaryID: treezor.String(b.GetBeneficiaryID().String()), Currency: treezor.EUR}
+		payout.SetAmountMoney(treezor.NewMoney(1000000, treezor.EUR))
+		_, _, err = client.Payout.Create(ctx, payout)
+		assert.True(t, errors.Is(err, treezor.ErrInsufficientFunds))
+
+		payout.SetAmountMoney(treezor.NewMoney(7500, treezor.EUR))
+		payout, _, err = client.Payout.Create(ctx, payout)
+		assert.Nil(t, err)
+		assert.Equal(t, "PENDING", payout.GetPayoutStatus())
+		assert.Equal(t, treezor.NewMoney(0, treezor.EUR), balance(w1))
+
+		assert.Nil(t, srv.ValidatePayout(payout.GetPayoutID()))
+		_, _, err = client.Payout.Delete(ctx, payout.GetPayoutID())
+		assert.NotNil(t, err)
+		assert.Equal(t, treezor.NewMoney(0, treezor.EUR), balance(w1))
+	})
+	t.Run("Pagination", func(t *testing.T) {
+		transfers, err := client.Transfer.ListAll(ctx, &treezor.TransferListOptions{ListOptions: treezor.ListOptions{PerPage: 1}})
+		assert.Nil(t, err)
+		assert.Len(t, transfers, 2)
+	})
+}
+
+func TestServer_Cards(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+	user, w, _ := setup(t, client)
+
+	card, _, err := client.Card.RequestPhysical(ctx, &treezor.Card{UserID: user.UserID, WalletID: w.WalletID})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), card.GetIsLive())
+	card, _, err = client.Card.Activate(ctx, card.GetCardID())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), card.GetIsLive())
+
+	t.Run("PIN blocked after wrong tries", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			_, _, err := client.Card.ChangePIN(ctx, card.GetCardID(), &treezor.PIN{Current: "0000", New: "1111", Confirmation: "1111"})
+			assert.True(t, errors.Is(err, treezor.ErrCardWrongPIN))
+		}
+		_, _, err := client.Card.ChangePIN(ctx, card.GetCardID(), &treezor.PIN{Current: defaultPIN, New: "1111", Confirmation: "1111"})
+		assert.True(t, errors.Is(err, treezor.ErrCardBlocked))
+
+		c, _, err := client.Card.UnblockPIN(ctx, card.GetCardID())
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), c.GetPINTryExceeds())
+		_, _, err = client.Card.ChangePIN(ctx, card.GetCardID(), &treezor.PIN{Current: defaultPIN, New: "1111", Confirmation: "1111"})
+		assert.Nil(t, err)
+	})
+	t.Run("Lost card cannot be unlocked", func(t *testing.T) {
+		c, _, err := client.Card.LockUnlock(ctx, card.GetCardID(), treezor.Lost)
+		assert.Nil(t, err)
+		assert.Equal(t, "LOST", c.GetStatusCode())
+		_, _, err = client.Card.LockUnlock(ctx, card.GetCardID(), treezor.Unlocked)
+		assert.True(t, errors.Is(err, treezor.ErrCardLost))
+	})
+}
+
+func TestServer_KYC(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+	ctx := context.Background()
+	user, _, _ := setup(t, client)
+
+	doc, _, err := client.Document.Send(ctx, &treezor.Document{
+		UserID:            user.UserID,
+		DocumentTypeID:    treezor.IdentityCard,
+		Name:              treezor.String("id.jpg"),
+		FileContentBase64: base64.StdEncoding.EncodeToString([]byte("jpeg")),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, treezor.DocumentStatusPending, doc.GetDocumentStatus())
+
+	user, _, err = client.User.ReviewKYC(ctx, user.GetUserID())
+	assert.Nil(t, err)
+	assert.Equal(t, treezor.ReviewPending, user.GetKycReview())
+
+	assert.Nil(t, srv.ReviewDocument(doc.GetDocumentID(), treezor.DocumentStatusValidated))
+	assert.Nil(t, srv.ReviewKYC(user.GetUserID(), treezor.LevelRegular, treezor.ReviewValidated, ""))
+	user, _, err = client.User.Get(ctx, user.GetUserID())
+	assert.Nil(t, err)
+	assert.Equal(t, treezor.ReviewValidated, user.GetKycReview())
+}
+
+func TestServer_Webhooks(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	var mu sync.Mutex
+	var received []string
+	router := treezor.NewWebhookRouter(srv.WebhookSecret)
+	router.Fallback(func(ctx context.Context, evt *treezor.Event, payload interface{}) error {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, evt.GetType())
+		return nil
+	})
+	hooks := httptest.NewServer(router)
+	defer hooks.Close()
+	srv.WebhookURL = hooks.URL
+
+	setup(t, srv.Client())
+
+	assert.Empty(t, srv.WebhookErrors())
+	assert.Equal(t, []string{"user.create", "wallet.create", "wallet.create"}, received)
+	assert.Len(t, srv.Events(), 3)
+}
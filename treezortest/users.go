@@ -0,0 +1,371 @@
+package treezortest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"strconv"
+
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+// Statuses of the objects of the API.
+const (
+	statusPending   = "PENDING"
+	statusValidated = "VALIDATED"
+	statusCanceled  = "CANCELED"
+)
+
+// merge applies the fields present in the JSON body of r to dst, leaving the
+// other fields untouched.
+func merge(r *request, dst interface{}) error {
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, dst); err != nil {
+		return badRequest("invalid JSON body: %v", err)
+	}
+	return nil
+}
+
+func (s *Server) user(id string) (*treezor.User, error) {
+	u, ok := s.users[id]
+	if !ok {
+		return nil, notFound("user", id)
+	}
+	return u, nil
+}
+
+// activeUser returns the user with the given ID if it is not canceled.
+func (s *Server) activeUser(id string) (*treezor.User, error) {
+	u, err := s.user(id)
+	if err != nil {
+		return nil, err
+	}
+	if u.GetUserStatus() == statusCanceled {
+		return nil, badRequest("user %s is canceled", id)
+	}
+	return u, nil
+}
+
+func (s *Server) createUser(r *request) (interface{}, error) {
+	u := &treezor.User{}
+	if err := r.decode(u); err != nil {
+		return nil, err
+	}
+	if u.GetEmail() == "" {
+		return nil, badRequest("email is required")
+	}
+	if u.UserTypeID == nil {
+		u.UserTypeID = treezor.String("1")
+	}
+	u.UserID = s.newStringID()
+	u.UserStatus = treezor.String(statusValidated)
+	level, review := treezor.LevelNone, treezor.ReviewNone
+	u.KycLevel, u.KycReview = &level, &review
+	u.IsFreezed = treezor.Int64(0)
+	u.CreatedDate = now()
+	s.users[u.GetUserID()] = u
+
+	resp := &treezor.UserResponse{Users: []*treezor.User{u}}
+	s.emit("user.create", u.GetUserID(), resp)
+	return resp, nil
+}
+
+func (s *Server) getUser(r *request) (interface{}, error) {
+	u, err := s.user(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	return &treezor.UserResponse{Users: []*treezor.User{u}}, nil
+}
+
+func (s *Server) listUsers(r *request) (interface{}, error) {
+	var ids []string
+	for id, u := range s.users {
+		if filter(r, "userStatus", u.UserStatus) && filter(r, "parentUserId", u.ParentUserID) {
+			ids = append(ids, id)
+		}
+	}
+	sortedIDs(ids)
+	start, end := page(r, len(ids))
+	resp := &treezor.UserResponse{Users: []*treezor.User{}}
+	for _, id := range ids[start:end] {
+		u := *s.users[id]
+		u.TotalRows = treezor.Int64(int64(len(ids)))
+		resp.Users = append(resp.Users, &u)
+	}
+	return resp, nil
+}
+
+func (s *Server) editUser(r *request) (interface{}, error) {
+	u, err := s.activeUser(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	id, status := u.UserID, u.UserStatus
+	if err := merge(r, u); err != nil {
+		return nil, err
+	}
+	u.UserID, u.UserStatus = id, status
+	u.ModifiedDate = now()
+
+	resp := &treezor.UserResponse{Users: []*treezor.User{u}}
+	s.emit("user.update", u.GetUserID(), resp)
+	return resp, nil
+}
+
+func (s *Server) cancelUser(r *request) (interface{}, error) {
+	u, err := s.activeUser(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range s.wallets {
+		if w.GetUserID() == u.GetUserID() && w.GetWalletStatus() != statusCanceled {
+			return nil, badRequest("user %s still has active wallets", u.GetUserID())
+		}
+	}
+	u.UserStatus = treezor.String(statusCanceled)
+	u.ModifiedDate = now()
+
+	resp := &treezor.UserResponse{Users: []*treezor.User{u}}
+	s.emit("user.cancel", u.GetUserID(), resp)
+	return resp, nil
+}
+
+func (s *Server) reviewKYC(r *request) (interface{}, error) {
+	u, err := s.activeUser(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	level, review := treezor.LevelPending, treezor.ReviewPending
+	u.KycLevel, u.KycReview = &level, &review
+	u.ModifiedDate = now()
+
+	resp := &treezor.UserResponse{Users: []*treezor.User{u}}
+	s.emit("user.kycrequest", u.GetUserID(), resp)
+	return resp, nil
+}
+
+// ReviewKYC sets the outcome of the KYC review of a user, as done by the
+// compliance team of Treezor, and emits a user.kycreview event.
+func (s *Server) ReviewKYC(userID string, level treezor.Level, review treezor.Review, comment string) error {
+	s.mu.Lock()
+	u, err := s.user(userID)
+	if err == nil {
+		u.KycLevel, u.KycReview = &level, &review
+		u.KycReviewComment = treezor.String(comment)
+		u.ModifiedDate = now()
+		s.emit("user.kycreview", userID, &treezor.UserResponse{Users: []*treezor.User{u}})
+	}
+	pending := s.flushWebhooks()
+	s.mu.Unlock()
+	s.sendWebhooks(pending)
+	return err
+}
+
+func (s *Server) requestKYCLiveness(r *request) (interface{}, error) {
+	u, err := s.activeUser(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	id := strconv.FormatInt(s.newID(), 10)
+	return &treezor.IdentificationResponse{
+		Identification: &treezor.Identification{
+			IdentificationID:  treezor.String(id),
+			IdentificationURL: treezor.String(s.URL + "/kycliveness/" + u.GetUserID() + "/" + id),
+		},
+	}, nil
+}
+
+func (s *Server) reviewKYCLiveness(r *request) (interface{}, error) {
+	if _, err := s.activeUser(r.params[0]); err != nil {
+		return nil, err
+	}
+	return struct{}{}, nil
+}
+
+func (s *Server) sendDocument(r *request) (interface{}, error) {
+	d := &treezor.Document{}
+	if err := r.decode(d); err != nil {
+		return nil, err
+	}
+	if _, err := s.activeUser(d.GetUserID()); err != nil {
+		return nil, err
+	}
+	content, err := base64.StdEncoding.DecodeString(d.FileContentBase64)
+	if err != nil || len(content) == 0 {
+		return nil, badRequest("fileContentBase64 must be a non-empty base64 string")
+	}
+	d.DocumentID = s.newStringID()
+	d.DocumentStatus = treezor.String(treezor.DocumentStatusPending)
+	d.DocumentType = treezor.String(d.DocumentTypeID.String())
+	d.FileSize = treezor.Int64(int64(len(content)))
+	d.FileContentBase64 = ""
+	d.CreatedDate = treezor.String(now().Format("2006-01-02 15:04:05"))
+	s.documents[d.GetDocumentID()] = d
+
+	resp := &treezor.DocumentResponse{Documents: []*treezor.Document{d}}
+	s.emit("document.create", d.GetDocumentID(), resp)
+	return resp, nil
+}
+
+func (s *Server) getDocument(r *request) (interface{}, error) {
+	d, ok := s.documents[r.params[0]]
+	if !ok {
+		return nil, notFound("document", r.params[0])
+	}
+	return &treezor.DocumentResponse{Documents: []*treezor.Document{d}}, nil
+}
+
+func (s *Server) deleteDocument(r *request) (interface{}, error) {
+	d, ok := s.documents[r.params[0]]
+	if !ok {
+		return nil, notFound("document", r.params[0])
+	}
+	if d.GetDocumentStatus() != treezor.DocumentStatusPending {
+		return nil, badRequest("document %s is %s", d.GetDocumentID(), d.GetDocumentStatus())
+	}
+	d.DocumentStatus = treezor.String(treezor.DocumentStatusCanceled)
+	d.ModifiedDate = treezor.String(now().Format("2006-01-02 15:04:05"))
+
+	resp := &treezor.DocumentResponse{Documents: []*treezor.Document{d}}
+	s.emit("document.cancel", d.GetDocumentID(), resp)
+	return resp, nil
+}
+
+// ReviewDocument sets the status of a pending document, as done by the
+// compliance team of Treezor, and emits a document.update event.
+func (s *Server) ReviewDocument(documentID, status string) error {
+	s.mu.Lock()
+	d, ok := s.documents[documentID]
+	var err error
+	switch {
+	case !ok:
+		err = notFound("document", documentID)
+	case d.GetDocumentStatus() != treezor.DocumentStatusPending:
+		err = badRequest("document %s is %s", documentID, d.GetDocumentStatus())
+	default:
+		d.DocumentStatus = treezor.String(status)
+		d.ModifiedDate = treezor.String(now().Format("2006-01-02 15:04:05"))
+		s.emit("document.update", documentID, &treezor.DocumentResponse{Documents: []*treezor.Document{d}})
+	}
+	pending := s.flushWebhooks()
+	s.mu.Unlock()
+	s.sendWebhooks(pending)
+	return err
+}
+
+func (s *Server) createTaxResidence(r *request) (interface{}, error) {
+	t := &treezor.TaxResidence{}
+	if err := r.decode(t); err != nil {
+		return nil, err
+	}
+	if _, err := s.activeUser(strconv.FormatInt(t.GetUserID(), 10)); err != nil {
+		return nil, err
+	}
+	if t.GetCountry() == "" {
+		return nil, badRequest("country is required")
+	}
+	t.ID = treezor.Int64(s.newID())
+	s.taxResidences[t.GetID()] = t
+	return &treezor.TaxResidencesResponse{TaxResidences: []*treezor.TaxResidence{t}}, nil
+}
+
+func (s *Server) editTaxResidence(r *request) (interface{}, error) {
+	id, _ := strconv.ParseInt(r.params[0], 10, 64)
+	t, ok := s.taxResidences[id]
+	if !ok {
+		return nil, notFound("tax residence", r.params[0])
+	}
+	userID := t.UserID
+	if err := merge(r, t); err != nil {
+		return nil, err
+	}
+	t.ID, t.UserID = treezor.Int64(id), userID
+	return &treezor.TaxResidencesResponse{TaxResidences: []*treezor.TaxResidence{t}}, nil
+}
+
+func (s *Server) createBeneficiary(r *request) (interface{}, error) {
+	b := &treezor.BeneficiaryRequest{}
+	if err := r.decode(b); err != nil {
+		return nil, err
+	}
+	if _, err := s.activeUser(b.GetUserID()); err != nil {
+		return nil, err
+	}
+	if b.GetName() == "" || b.GetIBAN() == "" {
+		return nil, badRequest("name and iban are required")
+	}
+	b.BeneficiaryID = s.newStringID()
+	b.Access = treezor.Access{}
+	s.beneficiaries[b.GetBeneficiaryID()] = b
+
+	resp := beneficiaryResponse(b)
+	s.emit("beneficiary.create", b.GetBeneficiaryID(), resp)
+	return resp, nil
+}
+
+func (s *Server) getBeneficiary(r *request) (interface{}, error) {
+	b, ok := s.beneficiaries[r.params[0]]
+	if !ok {
+		return nil, notFound("beneficiary", r.params[0])
+	}
+	return beneficiaryResponse(b), nil
+}
+
+func (s *Server) listBeneficiaries(r *request) (interface{}, error) {
+	var ids []string
+	for id, b := range s.beneficiaries {
+		if filter(r, "userId", b.UserID) {
+			ids = append(ids, id)
+		}
+	}
+	sortedIDs(ids)
+	start, end := page(r, len(ids))
+	resp := &treezor.BeneficiaryResponse{Beneficiaries: []*treezor.Beneficiary{}}
+	for _, id := range ids[start:end] {
+		resp.Beneficiaries = append(resp.Beneficiaries, beneficiaryResponse(s.beneficiaries[id]).Beneficiaries...)
+	}
+	return resp, nil
+}
+
+func (s *Server) editBeneficiary(r *request) (interface{}, error) {
+	b, ok := s.beneficiaries[r.params[0]]
+	if !ok {
+		return nil, notFound("beneficiary", r.params[0])
+	}
+	id, userID := b.BeneficiaryID, b.UserID
+	if err := merge(r, b); err != nil {
+		return nil, err
+	}
+	b.BeneficiaryID, b.UserID = id, userID
+	b.Access = treezor.Access{}
+
+	resp := beneficiaryResponse(b)
+	s.emit("beneficiary.update", b.GetBeneficiaryID(), resp)
+	return resp, nil
+}
+
+// beneficiaryResponse converts a stored beneficiary to the response format
+// of the API, where IDs are numbers.
+func beneficiaryResponse(b *treezor.BeneficiaryRequest) *treezor.BeneficiaryResponse {
+	id, userID := json.Number(b.GetBeneficiaryID()), json.Number(b.GetUserID())
+	return &treezor.BeneficiaryResponse{Beneficiaries: []*treezor.Beneficiary{{
+		BeneficiaryID:          &id,
+		UserID:                 &userID,
+		Tag:                    b.Tag,
+		NickName:               b.NickName,
+		Name:                   b.Name,
+		Address:                b.Address,
+		EncryptedIBAN:          b.IBAN,
+		BIC:                    b.BIC,
+		SEPACreditorIdentifier: b.SEPACreditorIdentifier,
+		UsableForSCT:           b.UsableForSCT,
+		SDDB2BWhitelist:        b.SDDB2BWhitelist,
+	}}}
+}
@@ -0,0 +1,183 @@
+package treezortest
+
+import (
+	"fmt"
+
+	treezor "github.com/tifo/treezor-sdk"
+)
+
+// wallet is a stored wallet along with its balance, in minor units of its
+// currency.
+type wallet struct {
+	*treezor.Wallet
+
+	balance        int64 // Settled operations.
+	authorizations int64 // Pending debits, e.g. pay-outs not yet executed.
+}
+
+func (w *wallet) money(minor int64) treezor.Money {
+	return treezor.NewMoney(minor, w.Currency)
+}
+
+// authorizedBalance returns the amount available for new debits.
+func (w *wallet) authorizedBalance() int64 {
+	return w.balance - w.authorizations
+}
+
+// view returns the wallet with its balance fields up to date.
+func (w *wallet) view() *treezor.Wallet {
+	v := *w.Wallet
+	v.Solde = treezor.Float64(w.money(w.balance).Float64())
+	v.AuthorizedBalance = treezor.Float64(w.money(w.authorizedBalance()).Float64())
+	return &v
+}
+
+func (w *wallet) balanceView() *treezor.Balance {
+	return &treezor.Balance{
+		WalletID:          w.WalletID,
+		CurrentBalance:    treezor.Float64(w.money(w.balance).Float64()),
+		Authorizations:    treezor.Float64(w.money(w.authorizations).Float64()),
+		AuthorizedBalance: treezor.Float64(w.money(w.authorizedBalance()).Float64()),
+		Currency:          w.Currency,
+		CalculationDate:   now(),
+	}
+}
+
+// minorUnits converts an amount of the API to minor units of the currency
+// of w. It fails if the amount is not strictly positive.
+func (w *wallet) minorUnits(amount *float64) (int64, error) {
+	if amount == nil || *amount <= 0 {
+		return 0, badRequest("amount must be positive")
+	}
+	return treezor.MoneyFromFloat(*amount, w.Currency).Amount, nil
+}
+
+func (s *Server) wallet(id string) (*wallet, error) {
+	w, ok := s.wallets[id]
+	if !ok {
+		return nil, notFound("wallet", id)
+	}
+	return w, nil
+}
+
+// activeWallet returns the wallet with the given ID if it is validated.
+func (s *Server) activeWallet(id string) (*wallet, error) {
+	w, err := s.wallet(id)
+	if err != nil {
+		return nil, err
+	}
+	if w.GetWalletStatus() != statusValidated {
+		return nil, badRequest("wallet %s is %s", id, w.GetWalletStatus())
+	}
+	return w, nil
+}
+
+// emitBalance emits a balance.update event for w.
+func (s *Server) emitBalance(w *wallet) {
+	s.emit("balance.update", w.GetWalletID(), &treezor.BalanceResponse{Balances: []*treezor.Balance{w.balanceView()}})
+}
+
+func (s *Server) createWallet(r *request) (interface{}, error) {
+	tw := &treezor.Wallet{}
+	if err := r.decode(tw); err != nil {
+		return nil, err
+	}
+	u, err := s.activeUser(tw.GetUserID())
+	if err != nil {
+		return nil, err
+	}
+	if !tw.Currency.Valid() {
+		return nil, badRequest("invalid currency %q", tw.Currency)
+	}
+	if tw.WalletTypeID == nil {
+		tw.WalletTypeID = treezor.String("9")
+	}
+	tw.WalletID = s.newStringID()
+	tw.WalletStatus = treezor.String(statusValidated)
+	tw.UserFirstname, tw.UserLastname = u.Firstname, u.Lastname
+	tw.BIC = treezor.String("TRZOFR21XXX")
+	tw.IBAN = treezor.String(fmt.Sprintf("FR7616798000010000%09s", tw.GetWalletID()))
+	tw.CreatedDate = now()
+	w := &wallet{Wallet: tw}
+	s.wallets[tw.GetWalletID()] = w
+
+	resp := &treezor.WalletResponse{Wallets: []*treezor.Wallet{w.view()}}
+	s.emit("wallet.create", tw.GetWalletID(), resp)
+	return resp, nil
+}
+
+func (s *Server) getWallet(r *request) (interface{}, error) {
+	w, err := s.wallet(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	return &treezor.WalletResponse{Wallets: []*treezor.Wallet{w.view()}}, nil
+}
+
+func (s *Server) listWallets(r *request) (interface{}, error) {
+	var ids []string
+	for id, w := range s.wallets {
+		if filter(r, "userId", w.UserID) && filter(r, "walletStatus", w.WalletStatus) {
+			ids = append(ids, id)
+		}
+	}
+	sortedIDs(ids)
+	start, end := page(r, len(ids))
+	resp := &treezor.WalletResponse{Wallets: []*treezor.Wallet{}}
+	for _, id := range ids[start:end] {
+		v := s.wallets[id].view()
+		v.TotalRows = treezor.Int64(int64(len(ids)))
+		resp.Wallets = append(resp.Wallets, v)
+	}
+	return resp, nil
+}
+
+func (s *Server) editWallet(r *request) (interface{}, error) {
+	w, err := s.activeWallet(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	tw := w.Wallet
+	id, status, userID, currency := tw.WalletID, tw.WalletStatus, tw.UserID, tw.Currency
+	if err := merge(r, tw); err != nil {
+		return nil, err
+	}
+	tw.WalletID, tw.WalletStatus, tw.UserID, tw.Currency = id, status, userID, currency
+	tw.ModifiedDate = now()
+
+	resp := &treezor.WalletResponse{Wallets: []*treezor.Wallet{w.view()}}
+	s.emit("wallet.update", tw.GetWalletID(), resp)
+	return resp, nil
+}
+
+func (s *Server) cancelWallet(r *request) (interface{}, error) {
+	w, err := s.activeWallet(r.params[0])
+	if err != nil {
+		return nil, err
+	}
+	if w.balance != 0 || w.authorizations != 0 {
+		return nil, badRequest("wallet %s balance is not zero", w.GetWalletID())
+	}
+	w.WalletStatus = treezor.String(statusCanceled)
+	w.ModifiedDate = now()
+
+	resp := &treezor.WalletResponse{Wallets: []*treezor.Wallet{w.view()}}
+	s.emit("wallet.cancel", w.GetWalletID(), resp)
+	return resp, nil
+}
+
+func (s *Server) listBalances(r *request) (interface{}, error) {
+	var ids []string
+	for id, w := range s.wallets {
+		if filter(r, "walletId", w.WalletID) && filter(r, "userId", w.UserID) {
+			ids = append(ids, id)
+		}
+	}
+	sortedIDs(ids)
+	start, end := page(r, len(ids))
+	resp := &treezor.BalanceResponse{Balances: []*treezor.Balance{}}
+	for _, id := range ids[start:end] {
+		resp.Balances = append(resp.Balances, s.wallets[id].balanceView())
+	}
+	return resp, nil
+}
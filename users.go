@@ -34,24 +34,24 @@ type User struct {
 	UserTag                    *string         `json:"userTag,omitempty"`
 	SpecifiedUSPerson          *string         `json:"specifiedUSPerson,omitempty"`
 	Title                      *string         `json:"title,omitempty"`
-	Firstname                  *string         `json:"firstname,omitempty"`
-	Lastname                   *string         `json:"lastname,omitempty"`
-	MiddleNames                *string         `json:"middleNames,omitempty"`
-	Birthday                   *Date           `json:"birthday,omitempty"`
-	Email                      *string         `json:"email,omitempty"`
-	Address1                   *string         `json:"address1,omitempty"`
-	Address2                   *string         `json:"address2,omitempty"`
-	Address3                   *string         `json:"address3,omitempty"`
-	Postcode                   *string         `json:"postcode,omitempty"`
+	Firstname                  *string         `json:"firstname,omitempty" treezor:"secret"`
+	Lastname                   *string         `json:"lastname,omitempty" treezor:"secret"`
+	MiddleNames                *string         `json:"middleNames,omitempty" treezor:"secret"`
+	Birthday                   *Date           `json:"birthday,omitempty" treezor:"secret"`
+	Email                      *string         `json:"email,omitempty" treezor:"secret"`
+	Address1                   *string         `json:"address1,omitempty" treezor:"secret"`
+	Address2                   *string         `json:"address2,omitempty" treezor:"secret"`
+	Address3                   *string         `json:"address3,omitempty" treezor:"secret"`
+	Postcode                   *string         `json:"postcode,omitempty" treezor:"secret"`
 	City                       *string         `json:"city,omitempty"`
 	State                      *string         `json:"state,omitempty"`
 	Country                    *string         `json:"country,omitempty"`
 	CountryName                *string         `json:"countryName,omitempty"`
-	Phone                      *string         `json:"phone,omitempty"`
-	Mobile                     *string         `json:"mobile,omitempty"`
+	Phone                      *string         `json:"phone,omitempty" treezor:"secret"`
+	Mobile                     *string         `json:"mobile,omitempty" treezor:"secret"`
 	Nationality                *string         `json:"nationality,omitempty"`
 	NationalityOther           *string         `json:"nationalityOther,omitempty"`
-	PlaceOfBirth               *string         `json:"placeOfBirth,omitempty"`
+	PlaceOfBirth               *string         `json:"placeOfBirth,omitempty" treezor:"secret"`
 	BirthCountry               *string         `json:"birthCountry,omitempty"`
 	Occupation                 *string         `json:"occupation,omitempty"`
 	Position                   *string         `json:"position,omitempty"`
@@ -78,7 +78,7 @@ type User struct {
 	CreatedDate                *TimestampParis `json:"createdDate,omitempty"`
 	ModifiedDate               *TimestampParis `json:"modifiedDate,omitempty"`
 	CodeStatus                 *string         `json:"codeStatus,omitempty"`
-	TaxNumber                  *string         `json:"taxNumber,omitempty"`
+	TaxNumber                  *string         `json:"taxNumber,omitempty" treezor:"secret"`
 	TaxResidence               *string         `json:"taxResidence,omitempty"`
 	ActivityOutsideEu          *string         `json:"activityOutsideEu,omitempty"`
 	EconomicSanctions          *string         `json:"economicSanctions,omitempty"`
@@ -149,7 +149,7 @@ func (s *UserService) Create(ctx context.Context, user *User) (*User, *http.Resp
 	req, _ := s.client.NewRequest(http.MethodPost, "users", user)
 
 	ur := new(UserResponse)
-	resp, err := s.client.Do(ctx, req, ur)
+	resp, err := s.client.Do(WithOperation(ctx, "UserService.Create"), req, ur)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -166,7 +166,7 @@ func (s *UserService) Get(ctx context.Context, userID string) (*User, *http.Resp
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	ur := new(UserResponse)
-	resp, err := s.client.Do(ctx, req, ur)
+	resp, err := s.client.Do(WithOperation(ctx, "UserService.Get"), req, ur)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -177,6 +177,18 @@ func (s *UserService) Get(ctx context.Context, userID string) (*User, *http.Resp
 	return ur.Users[0], resp, nil
 }
 
+// GetMany returns the users with the given IDs, in the same order,
+// fetching them concurrently. If some of them cannot be fetched, their entries
+// are nil and a *GetManyError holding the error of each ID is returned.
+func (s *UserService) GetMany(ctx context.Context, userIDs []string, opt *GetManyOptions) ([]*User, error) {
+	users := make([]*User, len(userIDs))
+	err := getMany(ctx, userIDs, opt, func(ctx context.Context, i int) (err error) {
+		users[i], _, err = s.Get(ctx, userIDs[i])
+		return err
+	})
+	return users, err
+}
+
 // UserListOptions contains options for listing users.
 type UserListOptions struct {
 	ListOptions
@@ -192,7 +204,7 @@ func (s *UserService) List(ctx context.Context, opt *UserListOptions) (*UserResp
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	ur := new(UserResponse)
-	resp, err := s.client.Do(ctx, req, ur)
+	resp, err := s.client.Do(WithOperation(ctx, "UserService.List"), req, ur)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -206,7 +218,7 @@ func (s *UserService) Edit(ctx context.Context, userID string, user *User) (*Use
 	req, _ := s.client.NewRequest(http.MethodPut, u, user)
 
 	ur := new(UserResponse)
-	resp, err := s.client.Do(ctx, req, ur)
+	resp, err := s.client.Do(WithOperation(ctx, "UserService.Edit"), req, ur)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -223,7 +235,7 @@ func (s *UserService) ReviewKYC(ctx context.Context, userID string) (*User, *htt
 	req, _ := s.client.NewRequest(http.MethodPut, u, nil)
 
 	ur := new(UserResponse)
-	resp, err := s.client.Do(ctx, req, ur)
+	resp, err := s.client.Do(WithOperation(ctx, "UserService.ReviewKYC"), req, ur)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -239,7 +251,7 @@ func (s *UserService) ReviewKYCLiveness(ctx context.Context, treezorUserID strin
 	u := fmt.Sprintf("users/%s/kycliveness", treezorUserID)
 	req, _ := s.client.NewRequestWithoutIndex(http.MethodPut, u, nil)
 
-	resp, err := s.client.Do(ctx, req, nil)
+	resp, err := s.client.Do(WithOperation(ctx, "UserService.ReviewKYCLiveness"), req, nil)
 	if err != nil {
 		return resp, errors.WithStack(err)
 	}
@@ -265,7 +277,7 @@ func (s *UserService) Cancel(ctx context.Context, userID string, opt *UserCancel
 	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
 
 	ur := new(UserResponse)
-	resp, err := s.client.Do(ctx, req, ur)
+	resp, err := s.client.Do(WithOperation(ctx, "UserService.Cancel"), req, ur)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -293,7 +305,7 @@ func (s *UserService) RequestKYCLiveness(ctx context.Context, treezorUserID stri
 	req, _ := s.client.NewRequestWithoutIndex(http.MethodPost, u, nil)
 
 	k := new(IdentificationResponse)
-	resp, err := s.client.Do(ctx, req, k)
+	resp, err := s.client.Do(WithOperation(ctx, "UserService.RequestKYCLiveness"), req, k)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
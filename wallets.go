@@ -40,27 +40,36 @@ type Wallet struct {
 	URLImage          *string         `json:"urlImage,omitempty"`
 	CreatedDate       *TimestampParis `json:"createdDate,omitempty"`
 	ModifiedDate      *TimestampParis `json:"modifiedDate,omitempty"`
-	UserFirstname     *string         `json:"userFirstname,omitempty"`
-	UserLastname      *string         `json:"userLastname,omitempty"`
+	UserFirstname     *string         `json:"userFirstname,omitempty" treezor:"secret"`
+	UserLastname      *string         `json:"userLastname,omitempty" treezor:"secret"`
 	CodeStatus        *string         `json:"codeStatus,omitempty"`
 	TariffID          *string         `json:"tariffId,omitempty"`
 	InformationStatus *string         `json:"informationStatus,omitempty"`
 	PayinCount        *int64          `json:"payinCount,string,omitempty"`
 	PayoutCount       *int64          `json:"payoutCount,string,omitempty"`
 	TransferCount     *int64          `json:"transferCount,string,omitempty"`
-	Solde             *float64        `json:"solde,string,omitempty"`
-	AuthorizedBalance *float64        `json:"authorizedBalance,string,omitempty"`
+	Solde             *float64        `json:"solde,string,omitempty" money:"Currency"`
+	AuthorizedBalance *float64        `json:"authorizedBalance,string,omitempty" money:"Currency"`
 	BIC               *string         `json:"bic,omitempty"`
-	IBAN              *string         `json:"iban,omitempty"`
+	IBAN              *string         `json:"iban,omitempty" treezor:"secret,last4"`
 	TotalRows         *int64          `json:"totalRows,omitempty"`
 }
 
 // Create creates a Treezor wallet.
+// It returns ErrInvalidCurrency, without calling the API, if Currency is not a
+// known ISO 4217 code.
 func (s *WalletService) Create(ctx context.Context, wallet *Wallet) (*Wallet, *http.Response, error) {
+	if wallet == nil {
+		return nil, nil, errors.New("missing wallet")
+	}
+	if err := wallet.Currency.validate(); err != nil {
+		return nil, nil, err
+	}
+
 	req, _ := s.client.NewRequest(http.MethodPost, "wallets", wallet)
 
 	w := new(WalletResponse)
-	resp, err := s.client.Do(ctx, req, w)
+	resp, err := s.client.Do(WithOperation(ctx, "WalletService.Create"), req, w)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -77,7 +86,7 @@ func (s *WalletService) Get(ctx context.Context, walletID string) (*Wallet, *htt
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	w := new(WalletResponse)
-	resp, err := s.client.Do(ctx, req, w)
+	resp, err := s.client.Do(WithOperation(ctx, "WalletService.Get"), req, w)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -88,6 +97,18 @@ func (s *WalletService) Get(ctx context.Context, walletID string) (*Wallet, *htt
 	return w.Wallets[0], resp, nil
 }
 
+// GetMany returns the wallets with the given IDs, in the same order,
+// fetching them concurrently. If some of them cannot be fetched, their entries
+// are nil and a *GetManyError holding the error of each ID is returned.
+func (s *WalletService) GetMany(ctx context.Context, walletIDs []string, opt *GetManyOptions) ([]*Wallet, error) {
+	wallets := make([]*Wallet, len(walletIDs))
+	err := getMany(ctx, walletIDs, opt, func(ctx context.Context, i int) (err error) {
+		wallets[i], _, err = s.Get(ctx, walletIDs[i])
+		return err
+	})
+	return wallets, err
+}
+
 // WalletListOptions contains options for listing wallets.
 type WalletListOptions struct {
 	ListOptions
@@ -103,7 +124,7 @@ func (s *WalletService) List(ctx context.Context, opt *WalletListOptions) (*Wall
 	req, _ := s.client.NewRequest(http.MethodGet, u, nil)
 
 	w := new(WalletResponse)
-	resp, err := s.client.Do(ctx, req, w)
+	resp, err := s.client.Do(WithOperation(ctx, "WalletService.List"), req, w)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -117,7 +138,7 @@ func (s *WalletService) Edit(ctx context.Context, walletID string, wallet *Walle
 	req, _ := s.client.NewRequest(http.MethodPut, u, wallet)
 
 	w := new(WalletResponse)
-	resp, err := s.client.Do(ctx, req, w)
+	resp, err := s.client.Do(WithOperation(ctx, "WalletService.Edit"), req, w)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
@@ -146,7 +167,7 @@ func (s *WalletService) Cancel(ctx context.Context, walletID string, opt *Wallet
 	req, _ := s.client.NewRequest(http.MethodDelete, u, nil)
 
 	w := new(WalletResponse)
-	resp, err := s.client.Do(ctx, req, w)
+	resp, err := s.client.Do(WithOperation(ctx, "WalletService.Cancel"), req, w)
 	if err != nil {
 		return nil, resp, errors.WithStack(err)
 	}
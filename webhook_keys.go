@@ -0,0 +1,105 @@
+package treezor
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookKey is a Treezor Webhook secret message accepted by a
+// WebhookValidator.
+type WebhookKey struct {
+	// ID identifies the key in logs and callbacks, e.g. "2021-03". It is never
+	// compared to anything sent by Treezor.
+	ID string
+
+	// Secret is the Treezor Webhook secret message.
+	Secret []byte
+
+	// ExpiresAt is the time after which the key is no longer accepted.
+	// The key never expires if zero.
+	ExpiresAt time.Time
+}
+
+func (k *WebhookKey) expired(now time.Time) bool {
+	return !k.ExpiresAt.IsZero() && !now.Before(k.ExpiresAt)
+}
+
+// WebhookValidator validates incoming Treezor Webhook event requests against
+// an ordered set of secret keys, so that the secret can be rotated without
+// rejecting the events signed with the previous one during the deploy.
+//
+// The first key is the current one; the following keys are deprecated and
+// are only accepted until their expiry time.
+//
+// Example usage, while rotating from oldSecret to newSecret:
+//
+//	v := treezor.NewWebhookValidator(
+//		treezor.WebhookKey{ID: "new", Secret: newSecret},
+//		treezor.WebhookKey{ID: "old", Secret: oldSecret, ExpiresAt: cutover},
+//	)
+//	v.OnDeprecatedKey = func(evt *treezor.Event, key *treezor.WebhookKey) {
+//		log.Printf("event %s signed with deprecated key %s", evt.GetID(), key.ID)
+//	}
+type WebhookValidator struct {
+	// Keys is the ordered set of accepted keys, current key first.
+	Keys []WebhookKey
+
+	// OnDeprecatedKey, if set, is called when an event is signed with a
+	// deprecated key, i.e. any key but the first one.
+	OnDeprecatedKey func(evt *Event, key *WebhookKey)
+
+	now func() time.Time
+}
+
+// NewWebhookValidator returns a WebhookValidator accepting the given keys,
+// current key first.
+func NewWebhookValidator(keys ...WebhookKey) *WebhookValidator {
+	return &WebhookValidator{Keys: keys}
+}
+
+// Validate validates an incoming Treezor Webhook event request like
+// ValidatePayload, and returns the event along with the key which matched
+// its signature.
+func (v *WebhookValidator) Validate(r *http.Request) (*Event, *WebhookKey, error) {
+	evt, err := decodeEvent(r)
+	if err != nil {
+		return evt, nil, err
+	}
+	key, err := v.ValidateEvent(evt)
+	return evt, key, err
+}
+
+// ValidateEvent checks the payload signature of an already decoded event
+// and returns the key which matched it.
+func (v *WebhookValidator) ValidateEvent(evt *Event) (*WebhookKey, error) {
+	if evt.RawPayload == nil {
+		return nil, errors.New("Webhook request has missing payload")
+	}
+	messageMAC, hashFunc, err := messageMAC(evt.GetPayloadSignature())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	now := time.Now
+	if v.now != nil {
+		now = v.now
+	}
+	t := now()
+	for i := range v.Keys {
+		key := &v.Keys[i]
+		if key.expired(t) {
+			continue
+		}
+		// checkMAC compares the signatures in constant time.
+		if !checkMAC(*evt.RawPayload, messageMAC, key.Secret, hashFunc) {
+			continue
+		}
+		if i > 0 && v.OnDeprecatedKey != nil {
+			v.OnDeprecatedKey(evt, key)
+		}
+		return key, nil
+	}
+	return nil, errors.New("payload signature check failed")
+}
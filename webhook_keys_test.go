@@ -0,0 +1,52 @@
+package treezor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookValidator_Validate(t *testing.T) {
+	now := time.Date(2021, 3, 4, 10, 0, 0, 0, time.UTC)
+	newValidator := func() (*WebhookValidator, *[]string) {
+		var deprecated []string
+		v := NewWebhookValidator(
+			WebhookKey{ID: "new", Secret: []byte("new")},
+			WebhookKey{ID: "old", Secret: []byte("old"), ExpiresAt: now.Add(time.Hour)},
+			WebhookKey{ID: "older", Secret: []byte("older"), ExpiresAt: now.Add(-time.Hour)},
+		)
+		v.OnDeprecatedKey = func(evt *Event, key *WebhookKey) {
+			deprecated = append(deprecated, key.ID)
+		}
+		v.now = func() time.Time { return now }
+		return v, &deprecated
+	}
+
+	t.Run("Success current key", func(t *testing.T) {
+		v, deprecated := newValidator()
+		evt, key, err := v.Validate(newWebhookRequest("payin.update", `{"payins":[]}`, []byte("new")))
+		assert.Nil(t, err)
+		assert.Equal(t, "payin.update", evt.GetType())
+		assert.Equal(t, "new", key.ID)
+		assert.Empty(t, *deprecated)
+	})
+	t.Run("Success deprecated key", func(t *testing.T) {
+		v, deprecated := newValidator()
+		_, key, err := v.Validate(newWebhookRequest("payin.update", `{"payins":[]}`, []byte("old")))
+		assert.Nil(t, err)
+		assert.Equal(t, "old", key.ID)
+		assert.Equal(t, []string{"old"}, *deprecated)
+	})
+	t.Run("Error expired key", func(t *testing.T) {
+		v, _ := newValidator()
+		_, key, err := v.Validate(newWebhookRequest("payin.update", `{"payins":[]}`, []byte("older")))
+		assert.NotNil(t, err)
+		assert.Nil(t, key)
+	})
+	t.Run("Error unknown key", func(t *testing.T) {
+		v, _ := newValidator()
+		_, _, err := v.Validate(newWebhookRequest("payin.update", `{"payins":[]}`, []byte("other")))
+		assert.NotNil(t, err)
+	})
+}
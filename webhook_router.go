@@ -0,0 +1,692 @@
+package treezor
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookHandler handles a validated webhook event. payload is the value
+// returned by Event.ParsePayload.
+type WebhookHandler func(ctx context.Context, evt *Event, payload interface{}) error
+
+// WebhookError lets a webhook handler choose the HTTP status code answered to
+// Treezor. Treezor redelivers the events which are not answered with a 2xx
+// status code.
+type WebhookError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *WebhookError) Error() string {
+	if e.Err == nil {
+		return http.StatusText(e.StatusCode)
+	}
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *WebhookError) Unwrap() error {
+	return e.Err
+}
+
+// WebhookRouter is an http.Handler which validates Treezor webhook events and
+// dispatches them to the handler registered for their type.
+//
+// Handler errors are mapped to HTTP status codes: a *WebhookError answers its
+// StatusCode, a context deadline answers 503 and any other error answers 500.
+// Events without handler are passed to the fallback handler, or acknowledged
+// with a 200 if there is none.
+//
+// When Store is set, the events already processed successfully are
+// acknowledged without calling their handler again, and stored events can be
+// dispatched again with Replay.
+//
+// Example usage:
+//
+//	router := treezor.NewWebhookRouter(webhookSecretKey)
+//	router.OnPayinUpdate(func(ctx context.Context, e *treezor.PayinUpdateEvent) error {
+//	  // Process pay-in...
+//	  return nil
+//	})
+//	http.Handle("/webhooks/treezor", router)
+type WebhookRouter struct {
+	// Store, if set, records the processing of events to skip redelivered
+	// events. See HandleOnce.
+	Store EventStore
+
+	// ClaimLease is how long an event claimed in Store is considered being
+	// processed, before its claim is assumed to be left over by a crashed
+	// process. It defaults to 5 minutes and should exceed the longest
+	// handler run.
+	ClaimLease time.Duration
+
+	validator *WebhookValidator
+	handlers  map[string]WebhookHandler
+	fallback  WebhookHandler
+}
+
+// NewWebhookRouter returns a WebhookRouter validating events with secretKey,
+// the Treezor Webhook secret message.
+func NewWebhookRouter(secretKey []byte) *WebhookRouter {
+	return NewWebhookRouterWithValidator(NewWebhookValidator(WebhookKey{Secret: secretKey}))
+}
+
+// NewWebhookRouterWithValidator returns a WebhookRouter validating events with
+// v, e.g. to accept several secret keys while rotating the secret.
+func NewWebhookRouterWithValidator(v *WebhookValidator) *WebhookRouter {
+	return &WebhookRouter{
+		validator: v,
+		handlers:  map[string]WebhookHandler{},
+	}
+}
+
+// On registers the handler of the given event type, e.g. "payin.update".
+// It replaces any handler previously registered for that type.
+func (r *WebhookRouter) On(eventType string, h WebhookHandler) {
+	r.handlers[eventType] = h
+}
+
+// Fallback registers the handler of the event types without handler.
+func (r *WebhookRouter) Fallback(h WebhookHandler) {
+	r.fallback = h
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (r *WebhookRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	evt, _, err := r.validator.Validate(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := handleOnce(req.Context(), r.Store, evt, r.claimLease(), r.Dispatch); err != nil {
+		status := webhookErrorStatus(err)
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Dispatch parses the payload of an already validated event and calls the
+// handler registered for its type.
+func (r *WebhookRouter) Dispatch(ctx context.Context, evt *Event) error {
+	h, ok := r.handlers[evt.GetType()]
+	if !ok {
+		h = r.fallback
+	}
+	if h == nil {
+		return nil
+	}
+
+	payload, err := evt.ParsePayload()
+	if err != nil {
+		return &WebhookError{StatusCode: http.StatusBadRequest, Err: err}
+	}
+	return h(withEvent(ctx, evt), evt, payload)
+}
+
+// Replay dispatches again the stored events for which match returns true, or
+// all stored events if match is nil, and records their new outcome. It is
+// meant to reprocess events after fixing a handler, e.g. the failed ones:
+//
+//	n, err := router.Replay(ctx, func(rec *treezor.EventRecord) bool {
+//		return rec.Status == treezor.EventFailed
+//	})
+//
+// Each event is claimed in the store before it is dispatched, and skipped if it
+// is being processed by a delivery or another replay.
+//
+// Replay goes on when a handler fails; it returns the number of dispatched
+// events and an error if any of them failed.
+func (r *WebhookRouter) Replay(ctx context.Context, match func(rec *EventRecord) bool) (int, error) {
+	if r.Store == nil {
+		return 0, errors.New("webhook router has no event store")
+	}
+	recs, err := r.Store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var n, failed int
+	var firstErr error
+	for _, rec := range recs {
+		if match != nil && !match(rec) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return n, errors.WithStack(err)
+		}
+		rec, claimed, err := r.Store.Reclaim(ctx, rec.Event.GetID(), r.claimLease())
+		switch {
+		case errors.Is(err, ErrEventNotFound):
+			continue // Evicted since List.
+		case err != nil:
+			return n, err
+		case !claimed:
+			continue
+		}
+		n++
+		if err := runEventRecord(ctx, r.Store, rec, r.Dispatch); err != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	if firstErr != nil {
+		return n, errors.Wrapf(firstErr, "%d of %d replayed events failed, first error", failed, n)
+	}
+	return n, nil
+}
+
+// claimLease returns the ClaimLease of r, or its default.
+func (r *WebhookRouter) claimLease() time.Duration {
+	if r.ClaimLease <= 0 {
+		return eventClaimLease
+	}
+	return r.ClaimLease
+}
+
+// webhookErrorStatus maps a handler error to an HTTP status code.
+func webhookErrorStatus(err error) int {
+	var werr *WebhookError
+	switch {
+	case errors.As(err, &werr):
+		return werr.StatusCode
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+type eventContextKey struct{}
+
+func withEvent(ctx context.Context, evt *Event) context.Context {
+	return context.WithValue(ctx, eventContextKey{}, evt)
+}
+
+// EventFromContext returns the webhook event being handled, as passed to the
+// typed handlers of a WebhookRouter.
+func EventFromContext(ctx context.Context) (*Event, bool) {
+	evt, ok := ctx.Value(eventContextKey{}).(*Event)
+	return evt, ok
+}
+
+// OnBalanceUpdate registers the handler of balance.update events.
+func (r *WebhookRouter) OnBalanceUpdate(h func(ctx context.Context, payload *BalanceUpdateEvent) error) {
+	r.On("balance.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*BalanceUpdateEvent))
+	})
+}
+
+// OnBankAccountCreate registers the handler of bankaccount.create events.
+func (r *WebhookRouter) OnBankAccountCreate(h func(ctx context.Context, payload *BankAccountCreateEvent) error) {
+	r.On("bankaccount.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*BankAccountCreateEvent))
+	})
+}
+
+// OnBankAccountUpdate registers the handler of bankaccount.update events.
+func (r *WebhookRouter) OnBankAccountUpdate(h func(ctx context.Context, payload *BankAccountUpdateEvent) error) {
+	r.On("bankaccount.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*BankAccountUpdateEvent))
+	})
+}
+
+// OnBankAccountCancel registers the handler of bankaccount.cancel events.
+func (r *WebhookRouter) OnBankAccountCancel(h func(ctx context.Context, payload *BankAccountCancelEvent) error) {
+	r.On("bankaccount.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*BankAccountCancelEvent))
+	})
+}
+
+// OnBeneficiaryCreate registers the handler of beneficiary.create events.
+func (r *WebhookRouter) OnBeneficiaryCreate(h func(ctx context.Context, payload *BeneficiaryCreateEvent) error) {
+	r.On("beneficiary.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*BeneficiaryCreateEvent))
+	})
+}
+
+// OnBeneficiaryUpdate registers the handler of beneficiary.update events.
+func (r *WebhookRouter) OnBeneficiaryUpdate(h func(ctx context.Context, payload *BeneficiaryUpdateEvent) error) {
+	r.On("beneficiary.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*BeneficiaryUpdateEvent))
+	})
+}
+
+// OnCardRequestPhysical registers the handler of card.requestphysical events.
+func (r *WebhookRouter) OnCardRequestPhysical(h func(ctx context.Context, payload *CardRequestPhysicalEvent) error) {
+	r.On("card.requestphysical", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardRequestPhysicalEvent))
+	})
+}
+
+// OnCardCreateVirtual registers the handler of card.createvirtual events.
+func (r *WebhookRouter) OnCardCreateVirtual(h func(ctx context.Context, payload *CardCreateVirtualEvent) error) {
+	r.On("card.createvirtual", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardCreateVirtualEvent))
+	})
+}
+
+// OnCardConvertVirtual registers the handler of card.convertvirtual events.
+func (r *WebhookRouter) OnCardConvertVirtual(h func(ctx context.Context, payload *CardConvertVirtualEvent) error) {
+	r.On("card.convertvirtual", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardConvertVirtualEvent))
+	})
+}
+
+// OnCardChangePIN registers the handler of card.changepin events.
+func (r *WebhookRouter) OnCardChangePIN(h func(ctx context.Context, payload *CardChangePINEvent) error) {
+	r.On("card.changepin", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardChangePINEvent))
+	})
+}
+
+// OnCardActivate registers the handler of card.activate events.
+func (r *WebhookRouter) OnCardActivate(h func(ctx context.Context, payload *CardActivateEvent) error) {
+	r.On("card.activate", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardActivateEvent))
+	})
+}
+
+// OnCardRenew registers the handler of card.renew events.
+func (r *WebhookRouter) OnCardRenew(h func(ctx context.Context, payload *CardRenewEvent) error) {
+	r.On("card.renew", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardRenewEvent))
+	})
+}
+
+// OnCardRegenerate registers the handler of card.regenerate events.
+func (r *WebhookRouter) OnCardRegenerate(h func(ctx context.Context, payload *CardRegenerateEvent) error) {
+	r.On("card.regenerate", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardRegenerateEvent))
+	})
+}
+
+// OnCardUpdate registers the handler of card.update events.
+func (r *WebhookRouter) OnCardUpdate(h func(ctx context.Context, payload *CardUpdateEvent) error) {
+	r.On("card.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardUpdateEvent))
+	})
+}
+
+// OnCardLimits registers the handler of card.limits events.
+func (r *WebhookRouter) OnCardLimits(h func(ctx context.Context, payload *CardLimitsEvent) error) {
+	r.On("card.limits", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardLimitsEvent))
+	})
+}
+
+// OnCardOptions registers the handler of card.options events.
+func (r *WebhookRouter) OnCardOptions(h func(ctx context.Context, payload *CardOptionsEvent) error) {
+	r.On("card.options", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardOptionsEvent))
+	})
+}
+
+// OnCardSetPIN registers the handler of card.setpin events.
+func (r *WebhookRouter) OnCardSetPIN(h func(ctx context.Context, payload *CardSetPINEvent) error) {
+	r.On("card.setpin", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardSetPINEvent))
+	})
+}
+
+// OnCardUnblockPIN registers the handler of card.unblockpin events.
+func (r *WebhookRouter) OnCardUnblockPIN(h func(ctx context.Context, payload *CardUnblockPINEvent) error) {
+	r.On("card.unblockpin", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardUnblockPINEvent))
+	})
+}
+
+// OnCardLockUnlock registers the handler of card.lockunlock events.
+func (r *WebhookRouter) OnCardLockUnlock(h func(ctx context.Context, payload *CardLockUnlockEvent) error) {
+	r.On("card.lockunlock", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardLockUnlockEvent))
+	})
+}
+
+// OnCardDigitalizationUpdate registers the handler of cardDigitalization.update events.
+func (r *WebhookRouter) OnCardDigitalizationUpdate(h func(ctx context.Context, payload *CardDigitalizationUpdateEvent) error) {
+	r.On("cardDigitalization.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardDigitalizationUpdateEvent))
+	})
+}
+
+// OnCardTransactionCreate registers the handler of cardtransaction.create events.
+func (r *WebhookRouter) OnCardTransactionCreate(h func(ctx context.Context, payload *CardTransactionCreateEvent) error) {
+	r.On("cardtransaction.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CardTransactionCreateEvent))
+	})
+}
+
+// OnCountryGroupCreate registers the handler of countryGroup.create events.
+func (r *WebhookRouter) OnCountryGroupCreate(h func(ctx context.Context, payload *CountryGroupCreateEvent) error) {
+	r.On("countryGroup.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CountryGroupCreateEvent))
+	})
+}
+
+// OnCountryGroupUpdate registers the handler of countryGroup.update events.
+func (r *WebhookRouter) OnCountryGroupUpdate(h func(ctx context.Context, payload *CountryGroupUpdateEvent) error) {
+	r.On("countryGroup.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CountryGroupUpdateEvent))
+	})
+}
+
+// OnCountryGroupCancel registers the handler of countryGroup.cancel events.
+func (r *WebhookRouter) OnCountryGroupCancel(h func(ctx context.Context, payload *CountryGroupCancelEvent) error) {
+	r.On("countryGroup.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*CountryGroupCancelEvent))
+	})
+}
+
+// OnDocumentCreate registers the handler of document.create events.
+func (r *WebhookRouter) OnDocumentCreate(h func(ctx context.Context, payload *DocumentCreateEvent) error) {
+	r.On("document.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*DocumentCreateEvent))
+	})
+}
+
+// OnDocumentUpdate registers the handler of document.update events.
+func (r *WebhookRouter) OnDocumentUpdate(h func(ctx context.Context, payload *DocumentUpdateEvent) error) {
+	r.On("document.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*DocumentUpdateEvent))
+	})
+}
+
+// OnDocumentCancel registers the handler of document.cancel events.
+func (r *WebhookRouter) OnDocumentCancel(h func(ctx context.Context, payload *DocumentCancelEvent) error) {
+	r.On("document.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*DocumentCancelEvent))
+	})
+}
+
+// OnMandateCreate registers the handler of mandate.create events.
+func (r *WebhookRouter) OnMandateCreate(h func(ctx context.Context, payload *MandateCreateEvent) error) {
+	r.On("mandate.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MandateCreateEvent))
+	})
+}
+
+// OnMandateSign registers the handler of mandate.sign events.
+func (r *WebhookRouter) OnMandateSign(h func(ctx context.Context, payload *MandateSignEvent) error) {
+	r.On("mandate.sign", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MandateSignEvent))
+	})
+}
+
+// OnMandateCancel registers the handler of mandate.cancel events.
+func (r *WebhookRouter) OnMandateCancel(h func(ctx context.Context, payload *MandateCancelEvent) error) {
+	r.On("mandate.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MandateCancelEvent))
+	})
+}
+
+// OnMerchantIDGroupCreate registers the handler of merchantIdGroup.create events.
+func (r *WebhookRouter) OnMerchantIDGroupCreate(h func(ctx context.Context, payload *MerchantIDGroupCreateEvent) error) {
+	r.On("merchantIdGroup.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MerchantIDGroupCreateEvent))
+	})
+}
+
+// OnMerchantIDGroupUpdate registers the handler of merchantIdGroup.update events.
+func (r *WebhookRouter) OnMerchantIDGroupUpdate(h func(ctx context.Context, payload *MerchantIDGroupUpdateEvent) error) {
+	r.On("merchantIdGroup.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MerchantIDGroupUpdateEvent))
+	})
+}
+
+// OnMerchantIDGroupCancel registers the handler of merchantIdGroup.cancel events.
+func (r *WebhookRouter) OnMerchantIDGroupCancel(h func(ctx context.Context, payload *MerchantIDGroupCancelEvent) error) {
+	r.On("merchantIdGroup.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MerchantIDGroupCancelEvent))
+	})
+}
+
+// OnMCCGroupCreate registers the handler of mccGroup.create events.
+func (r *WebhookRouter) OnMCCGroupCreate(h func(ctx context.Context, payload *MCCGroupCreateEvent) error) {
+	r.On("mccGroup.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MCCGroupCreateEvent))
+	})
+}
+
+// OnMCCGroupCancel registers the handler of mccGroup.cancel events.
+func (r *WebhookRouter) OnMCCGroupCancel(h func(ctx context.Context, payload *MCCGroupCancelEvent) error) {
+	r.On("mccGroup.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MCCGroupCancelEvent))
+	})
+}
+
+// OnMCCGroupUpdate registers the handler of mccGroup.update events.
+func (r *WebhookRouter) OnMCCGroupUpdate(h func(ctx context.Context, payload *MCCGroupUpdateEvent) error) {
+	r.On("mccGroup.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*MCCGroupUpdateEvent))
+	})
+}
+
+// OnOneClickCardCreate registers the handler of oneclickcard.create events.
+func (r *WebhookRouter) OnOneClickCardCreate(h func(ctx context.Context, payload *OneClickCardCreateEvent) error) {
+	r.On("oneclickcard.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*OneClickCardCreateEvent))
+	})
+}
+
+// OnOneClickCardUpdate registers the handler of oneclickcard.update events.
+func (r *WebhookRouter) OnOneClickCardUpdate(h func(ctx context.Context, payload *OneClickCardUpdateEvent) error) {
+	r.On("oneclickcard.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*OneClickCardUpdateEvent))
+	})
+}
+
+// OnOneClickCardCancel registers the handler of oneclickcard.cancel events.
+func (r *WebhookRouter) OnOneClickCardCancel(h func(ctx context.Context, payload *OneClickCardCancelEvent) error) {
+	r.On("oneclickcard.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*OneClickCardCancelEvent))
+	})
+}
+
+// OnPayinCreate registers the handler of payin.create events.
+func (r *WebhookRouter) OnPayinCreate(h func(ctx context.Context, payload *PayinCreateEvent) error) {
+	r.On("payin.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayinCreateEvent))
+	})
+}
+
+// OnPayinUpdate registers the handler of payin.update events.
+func (r *WebhookRouter) OnPayinUpdate(h func(ctx context.Context, payload *PayinUpdateEvent) error) {
+	r.On("payin.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayinUpdateEvent))
+	})
+}
+
+// OnPayinCancel registers the handler of payin.cancel events.
+func (r *WebhookRouter) OnPayinCancel(h func(ctx context.Context, payload *PayinCancelEvent) error) {
+	r.On("payin.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayinCancelEvent))
+	})
+}
+
+// OnPayinRefundCreate registers the handler of payinrefund.create events.
+func (r *WebhookRouter) OnPayinRefundCreate(h func(ctx context.Context, payload *PayinRefundCreateEvent) error) {
+	r.On("payinrefund.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayinRefundCreateEvent))
+	})
+}
+
+// OnPayinRefundUpdate registers the handler of payinrefund.update events.
+func (r *WebhookRouter) OnPayinRefundUpdate(h func(ctx context.Context, payload *PayinRefundUpdateEvent) error) {
+	r.On("payinrefund.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayinRefundUpdateEvent))
+	})
+}
+
+// OnPayinRefundCancel registers the handler of payinrefund.cancel events.
+func (r *WebhookRouter) OnPayinRefundCancel(h func(ctx context.Context, payload *PayinRefundCancelEvent) error) {
+	r.On("payinrefund.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayinRefundCancelEvent))
+	})
+}
+
+// OnPayoutCreate registers the handler of payout.create events.
+func (r *WebhookRouter) OnPayoutCreate(h func(ctx context.Context, payload *PayoutCreateEvent) error) {
+	r.On("payout.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayoutCreateEvent))
+	})
+}
+
+// OnPayoutUpdate registers the handler of payout.update events.
+func (r *WebhookRouter) OnPayoutUpdate(h func(ctx context.Context, payload *PayoutUpdateEvent) error) {
+	r.On("payout.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayoutUpdateEvent))
+	})
+}
+
+// OnPayoutCancel registers the handler of payout.cancel events.
+func (r *WebhookRouter) OnPayoutCancel(h func(ctx context.Context, payload *PayoutCancelEvent) error) {
+	r.On("payout.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*PayoutCancelEvent))
+	})
+}
+
+// OnSepaSctrReturn registers the handler of sepa.return_sctr events.
+func (r *WebhookRouter) OnSepaSctrReturn(h func(ctx context.Context, payload *SepaSctrReturnEvent) error) {
+	r.On("sepa.return_sctr", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*SepaSctrReturnEvent))
+	})
+}
+
+// OnSepaSddrCoreReject registers the handler of sepa.reject_sddr_core events.
+func (r *WebhookRouter) OnSepaSddrCoreReject(h func(ctx context.Context, payload *SepaSddrCoreRejectEvent) error) {
+	r.On("sepa.reject_sddr_core", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*SepaSddrCoreRejectEvent))
+	})
+}
+
+// OnSepaSddrB2BReject registers the handler of sepa.reject_sddr_b2b events.
+func (r *WebhookRouter) OnSepaSddrB2BReject(h func(ctx context.Context, payload *SepaSddrB2BRejectEvent) error) {
+	r.On("sepa.reject_sddr_b2b", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*SepaSddrB2BRejectEvent))
+	})
+}
+
+// OnTransactionCreate registers the handler of transaction.create events.
+func (r *WebhookRouter) OnTransactionCreate(h func(ctx context.Context, payload *TransactionCreateEvent) error) {
+	r.On("transaction.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*TransactionCreateEvent))
+	})
+}
+
+// OnTransferCreate registers the handler of transfer.create events.
+func (r *WebhookRouter) OnTransferCreate(h func(ctx context.Context, payload *TransferCreateEvent) error) {
+	r.On("transfer.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*TransferCreateEvent))
+	})
+}
+
+// OnTransferUpdate registers the handler of transfer.update events.
+func (r *WebhookRouter) OnTransferUpdate(h func(ctx context.Context, payload *TransferUpdateEvent) error) {
+	r.On("transfer.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*TransferUpdateEvent))
+	})
+}
+
+// OnTransferCancel registers the handler of transfer.cancel events.
+func (r *WebhookRouter) OnTransferCancel(h func(ctx context.Context, payload *TransferCancelEvent) error) {
+	r.On("transfer.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*TransferCancelEvent))
+	})
+}
+
+// OnTransferRefundCreate registers the handler of transferrefund.create events.
+func (r *WebhookRouter) OnTransferRefundCreate(h func(ctx context.Context, payload *TransferRefundCreateEvent) error) {
+	r.On("transferrefund.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*TransferRefundCreateEvent))
+	})
+}
+
+// OnTransferRefundUpdate registers the handler of transferrefund.update events.
+func (r *WebhookRouter) OnTransferRefundUpdate(h func(ctx context.Context, payload *TransferRefundUpdateEvent) error) {
+	r.On("transferrefund.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*TransferRefundUpdateEvent))
+	})
+}
+
+// OnTransferRefundCancel registers the handler of transferrefund.cancel events.
+func (r *WebhookRouter) OnTransferRefundCancel(h func(ctx context.Context, payload *TransferRefundCancelEvent) error) {
+	r.On("transferrefund.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*TransferRefundCancelEvent))
+	})
+}
+
+// OnUserCreate registers the handler of user.create events.
+func (r *WebhookRouter) OnUserCreate(h func(ctx context.Context, payload *UserCreateEvent) error) {
+	r.On("user.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*UserCreateEvent))
+	})
+}
+
+// OnUserUpdate registers the handler of user.update events.
+func (r *WebhookRouter) OnUserUpdate(h func(ctx context.Context, payload *UserUpdateEvent) error) {
+	r.On("user.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*UserUpdateEvent))
+	})
+}
+
+// OnUserCancel registers the handler of user.cancel events.
+func (r *WebhookRouter) OnUserCancel(h func(ctx context.Context, payload *UserCancelEvent) error) {
+	r.On("user.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*UserCancelEvent))
+	})
+}
+
+// OnUserKYCRequest registers the handler of user.kycrequest events.
+func (r *WebhookRouter) OnUserKYCRequest(h func(ctx context.Context, payload *UserKYCRequestEvent) error) {
+	r.On("user.kycrequest", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*UserKYCRequestEvent))
+	})
+}
+
+// OnUserKYCReview registers the handler of user.kycreview events.
+func (r *WebhookRouter) OnUserKYCReview(h func(ctx context.Context, payload *UserKYCReviewEvent) error) {
+	r.On("user.kycreview", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*UserKYCReviewEvent))
+	})
+}
+
+// OnWalletCreate registers the handler of wallet.create events.
+func (r *WebhookRouter) OnWalletCreate(h func(ctx context.Context, payload *WalletCreateEvent) error) {
+	r.On("wallet.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*WalletCreateEvent))
+	})
+}
+
+// OnWalletUpdate registers the handler of wallet.update events.
+func (r *WebhookRouter) OnWalletUpdate(h func(ctx context.Context, payload *WalletUpdateEvent) error) {
+	r.On("wallet.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*WalletUpdateEvent))
+	})
+}
+
+// OnWalletCancel registers the handler of wallet.cancel events.
+func (r *WebhookRouter) OnWalletCancel(h func(ctx context.Context, payload *WalletCancelEvent) error) {
+	r.On("wallet.cancel", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*WalletCancelEvent))
+	})
+}
+
+// OnKycLivenessCreate registers the handler of kycliveness.create events.
+func (r *WebhookRouter) OnKycLivenessCreate(h func(ctx context.Context, payload *KycLivenessCreateEvent) error) {
+	r.On("kycliveness.create", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*KycLivenessCreateEvent))
+	})
+}
+
+// OnKycLivenessUpdate registers the handler of kycliveness.update events.
+func (r *WebhookRouter) OnKycLivenessUpdate(h func(ctx context.Context, payload *KycLivenessUpdateEvent) error) {
+	r.On("kycliveness.update", func(ctx context.Context, evt *Event, payload interface{}) error {
+		return h(ctx, payload.(*KycLivenessUpdateEvent))
+	})
+}
@@ -0,0 +1,92 @@
+package treezor
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var testWebhookSecret = []byte("secret")
+
+// newWebhookRequest returns a webhook request whose payload is signed with key.
+func newWebhookRequest(eventType, payload string, key []byte) *http.Request {
+	raw := json.RawMessage(payload)
+	signature := base64.StdEncoding.EncodeToString(genMAC(raw, key, sha256.New))
+	body, _ := json.Marshal(&Event{
+		ID:               String("1"),
+		Type:             String(eventType),
+		RawPayload:       &raw,
+		PayloadSignature: &signature,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestWebhookRouter_ServeHTTP(t *testing.T) {
+	t.Run("Success typed handler", func(t *testing.T) {
+		router := NewWebhookRouter(testWebhookSecret)
+		var got *PayinUpdateEvent
+		router.OnPayinUpdate(func(ctx context.Context, e *PayinUpdateEvent) error {
+			evt, ok := EventFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, "1", evt.GetID())
+			got = e
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newWebhookRequest("payin.update", `{"payins":[{"payinId":"42"}]}`, testWebhookSecret))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "42", got.Payins[0].GetPayinID())
+	})
+	t.Run("Error invalid signature", func(t *testing.T) {
+		router := NewWebhookRouter(testWebhookSecret)
+		router.OnPayinUpdate(func(ctx context.Context, e *PayinUpdateEvent) error {
+			t.Fatal("handler should not be called")
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newWebhookRequest("payin.update", `{"payins":[]}`, []byte("other")))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+	t.Run("Error status from handler", func(t *testing.T) {
+		router := NewWebhookRouter(testWebhookSecret)
+		router.OnCardTransactionCreate(func(ctx context.Context, e *CardTransactionCreateEvent) error {
+			return errors.WithStack(&WebhookError{StatusCode: http.StatusConflict})
+		})
+		router.OnPayinCreate(func(ctx context.Context, e *PayinCreateEvent) error {
+			return errors.New("boom")
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newWebhookRequest("cardtransaction.create", `{"cardtransactions":[]}`, testWebhookSecret))
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, newWebhookRequest("payin.create", `{"payins":[]}`, testWebhookSecret))
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Success fallback", func(t *testing.T) {
+		router := NewWebhookRouter(testWebhookSecret)
+		var fallbackType string
+		router.Fallback(func(ctx context.Context, evt *Event, payload interface{}) error {
+			fallbackType = evt.GetType()
+			return nil
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, newWebhookRequest("unknown.event", `{}`, testWebhookSecret))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "unknown.event", fallbackType)
+	})
+}